@@ -0,0 +1,109 @@
+package main
+
+import (
+	"math"
+	"time"
+)
+
+type EloConfig struct {
+	KFactor float64
+	// Rating points added to the home team when computing win probabilities
+	HomeAdvantage float64
+	// Share of the distance to the initial rating removed before a team's first game of a season
+	SeasonRegression float64
+	InitialRating    float64
+}
+
+// Values of FiveThirtyEight's MLB model, without its pitcher and travel adjustments
+var DefaultEloConfig = EloConfig{
+	KFactor:          4,
+	HomeAdvantage:    24,
+	SeasonRegression: 1.0 / 3,
+	InitialRating:    1500,
+}
+
+type TeamRating struct {
+	Team           string
+	Opponent       string
+	Date           time.Time
+	NumberOfGame   string
+	RatingBefore   float64
+	RatingAfter    float64
+	WinProbability float64
+}
+
+func eloWinProbability(rating float64, opponentRating float64) float64 {
+	return 1 / (1 + math.Pow(10, (opponentRating-rating)/400))
+}
+
+type eloTeamState struct {
+	rating float64
+	season int
+}
+
+// Games must be in the order they were played. Returns two ratings per game,
+// visiting team first. Ties count as half a win.
+func computeEloRatings(games []GameScore, config EloConfig) []TeamRating {
+	teams := make(map[string]*eloTeamState)
+
+	ratingBefore := func(team string, season int) *eloTeamState {
+		state, ok := teams[team]
+
+		if !ok {
+			state = &eloTeamState{rating: config.InitialRating, season: season}
+			teams[team] = state
+		} else if state.season != season {
+			state.rating -= (state.rating - config.InitialRating) * config.SeasonRegression
+			state.season = season
+		}
+
+		return state
+	}
+
+	ratings := []TeamRating{}
+
+	for _, game := range games {
+		season := game.Date.Year()
+
+		visiting := ratingBefore(game.VisitingTeam, season)
+		home := ratingBefore(game.HomeTeam, season)
+
+		homeProbability := eloWinProbability(home.rating+config.HomeAdvantage, visiting.rating)
+
+		homeResult := 0.5
+
+		if game.HomeTeamScore > game.VisitingTeamScore {
+			homeResult = 1
+		} else if game.HomeTeamScore < game.VisitingTeamScore {
+			homeResult = 0
+		}
+
+		shift := config.KFactor * (homeResult - homeProbability)
+
+		ratings = append(ratings,
+			TeamRating{
+				Team:           game.VisitingTeam,
+				Opponent:       game.HomeTeam,
+				Date:           game.Date,
+				NumberOfGame:   game.NumberOfGame,
+				RatingBefore:   visiting.rating,
+				RatingAfter:    visiting.rating - shift,
+				WinProbability: 1 - homeProbability,
+			},
+			TeamRating{
+				Team:           game.HomeTeam,
+				Opponent:       game.VisitingTeam,
+				Date:           game.Date,
+				NumberOfGame:   game.NumberOfGame,
+				RatingBefore:   home.rating,
+				RatingAfter:    home.rating + shift,
+				WinProbability: homeProbability,
+			},
+		)
+
+		visiting.rating -= shift
+		home.rating += shift
+	}
+
+	return ratings
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"math"
+)
+
+// Exponent used by Baseball-Reference, slightly better fit than James' original 2
+const pythagoreanExponent = 1.83
+
+// Exponent of Pythagenpat is the run environment, runs per game, raised to this power
+const pythagenpatPower = 0.287
+
+type ExpectedRecord struct {
+	Wins   int     `json:"wins"`
+	Losses int     `json:"losses"`
+	Pct    float64 `json:"pct"`
+	// Actual wins minus expected wins
+	Difference int `json:"difference"`
+}
+
+type ExpectedRecords struct {
+	Pythagorean ExpectedRecord `json:"pythagorean"`
+	Pythagenpat ExpectedRecord `json:"pythagenpat"`
+	BaseRuns    ExpectedRecord `json:"base_runs"`
+	// Runs scored and allowed estimated by BaseRuns
+	BaseRunsScored  float64 `json:"base_runs_scored"`
+	BaseRunsAllowed float64 `json:"base_runs_allowed"`
+}
+
+func winPct(runsScored float64, runsAllowed float64, exponent float64) float64 {
+	if runsScored+runsAllowed <= 0 {
+		return 0.5
+	}
+
+	scored := math.Pow(runsScored, exponent)
+
+	return scored / (scored + math.Pow(runsAllowed, exponent))
+}
+
+func pythagenpatExponent(runsScored float64, runsAllowed float64, games int) float64 {
+	if games == 0 {
+		return pythagoreanExponent
+	}
+
+	return math.Pow((runsScored+runsAllowed)/float64(games), pythagenpatPower)
+}
+
+// Tango's BaseRuns, A * B / (B + C) + D
+func baseRuns(batting Batting) float64 {
+	singles := batting.Hits - batting.Doubles - batting.Triples - batting.HomeRuns
+	totalBases := singles + 2*batting.Doubles + 3*batting.Triples + 4*batting.HomeRuns
+
+	a := float64(batting.Hits+batting.Walks+batting.HitByPitch-batting.HomeRuns) - 0.5*float64(batting.IntentionalWalks)
+	b := (1.4*float64(totalBases) - 0.6*float64(batting.Hits) - 3*float64(batting.HomeRuns) +
+		0.1*float64(batting.Walks+batting.HitByPitch-batting.IntentionalWalks) +
+		0.9*float64(batting.StolenBases-batting.CaughtStealing-batting.GroundedIntoDoublePlay)) * 1.1
+	c := float64(batting.AtBats - batting.Hits + batting.CaughtStealing + batting.GroundedIntoDoublePlay)
+	d := float64(batting.HomeRuns)
+
+	if b+c <= 0 {
+		return d
+	}
+
+	return a*b/(b+c) + d
+}
+
+// Expected wins and losses over the games that had a decision
+func expectedRecord(pct float64, wins int, losses int) ExpectedRecord {
+	expectedWins := int(math.Round(pct * float64(wins+losses)))
+
+	return ExpectedRecord{
+		Wins:       expectedWins,
+		Losses:     wins + losses - expectedWins,
+		Pct:        math.Round(pct*1000) / 1000,
+		Difference: wins - expectedWins,
+	}
+}
+
+func computeExpectedRecords(totals *TeamSeasonTotals) ExpectedRecords {
+	scored := float64(totals.Batting.Runs)
+	allowed := float64(totals.OpponentBatting.Runs)
+
+	baseRunsScored := baseRuns(totals.Batting)
+	baseRunsAllowed := baseRuns(totals.OpponentBatting)
+
+	return ExpectedRecords{
+		Pythagorean: expectedRecord(
+			winPct(scored, allowed, pythagoreanExponent), totals.Wins, totals.Losses),
+		Pythagenpat: expectedRecord(
+			winPct(scored, allowed, pythagenpatExponent(scored, allowed, totals.Games)), totals.Wins, totals.Losses),
+		BaseRuns: expectedRecord(
+			winPct(baseRunsScored, baseRunsAllowed, pythagenpatExponent(baseRunsScored, baseRunsAllowed, totals.Games)),
+			totals.Wins, totals.Losses),
+		BaseRunsScored:  math.Round(baseRunsScored*10) / 10,
+		BaseRunsAllowed: math.Round(baseRunsAllowed*10) / 10,
+	}
+}
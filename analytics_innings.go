@@ -0,0 +1,144 @@
+package main
+
+// Innings after which a team that was behind and won counts as a late comeback
+const lateComebackInning = 6
+
+// Runs in an inning from which it is a big inning
+const bigInningRuns = 4
+
+// One team's view of a game, innings not batted are -1
+type TeamInnings struct {
+	Team            string
+	Home            bool
+	Innings         []int
+	OpponentInnings []int
+	Runs            int
+	RunsAllowed     int
+}
+
+// Both teams' views of the game, nil when the line scores are missing or don't add up to the score
+func getTeamInnings(game *GameLineScore) []TeamInnings {
+	visiting := parseLineScore(game.VisitingLineScore)
+	home := parseLineScore(game.HomeLineScore)
+
+	if len(visiting) == 0 || len(home) == 0 ||
+		sumRuns(visiting) != game.VisitingTeamScore || sumRuns(home) != game.HomeTeamScore {
+		return nil
+	}
+
+	return []TeamInnings{
+		{
+			Team:            game.VisitingTeam,
+			Innings:         visiting,
+			OpponentInnings: home,
+			Runs:            game.VisitingTeamScore,
+			RunsAllowed:     game.HomeTeamScore,
+		},
+		{
+			Team:            game.HomeTeam,
+			Home:            true,
+			Innings:         home,
+			OpponentInnings: visiting,
+			Runs:            game.HomeTeamScore,
+			RunsAllowed:     game.VisitingTeamScore,
+		},
+	}
+}
+
+func sumRuns(innings []int) int {
+	runs := 0
+
+	for _, inning := range innings {
+		if inning > 0 {
+			runs += inning
+		}
+	}
+
+	return runs
+}
+
+// Runs of the innings up to and including the given one
+func runsThrough(innings []int, inning int) int {
+	if inning > len(innings) {
+		inning = len(innings)
+	}
+
+	return sumRuns(innings[:inning])
+}
+
+// Whether the team scored first, and whether anyone scored at all
+func scoredFirst(game *TeamInnings) (bool, bool) {
+	visiting := game.Innings
+	home := game.OpponentInnings
+
+	if game.Home {
+		visiting, home = home, visiting
+	}
+
+	for i := 0; i < len(visiting) || i < len(home); i++ {
+		if i < len(visiting) && visiting[i] > 0 {
+			return !game.Home, true
+		}
+
+		if i < len(home) && home[i] > 0 {
+			return game.Home, true
+		}
+	}
+
+	return false, false
+}
+
+type InningsStats struct {
+	Games int `json:"games"`
+	// Runs in each of the first nine innings, extra innings are summed separately
+	RunsByInning      [9]int `json:"runs_by_inning"`
+	ExtraInningRuns   int    `json:"extra_inning_runs"`
+	FirstInningScored int    `json:"first_inning_scored"`
+	ScoredFirst       int    `json:"scored_first"`
+	ScoredFirstWins   int    `json:"scored_first_wins"`
+	LateInningRuns    int    `json:"runs_in_innings_7_to_9"`
+	BigInnings        int    `json:"big_innings"`
+	LateComebackWins  int    `json:"late_comeback_wins"`
+}
+
+func (stats *InningsStats) add(game *TeamInnings) {
+	stats.Games++
+
+	for i, runs := range game.Innings {
+		if runs <= 0 {
+			continue
+		}
+
+		if i < len(stats.RunsByInning) {
+			stats.RunsByInning[i] += runs
+		} else {
+			stats.ExtraInningRuns += runs
+		}
+
+		if i >= 6 && i < 9 {
+			stats.LateInningRuns += runs
+		}
+
+		if runs >= bigInningRuns {
+			stats.BigInnings++
+		}
+	}
+
+	if len(game.Innings) > 0 && game.Innings[0] > 0 {
+		stats.FirstInningScored++
+	}
+
+	won := game.Runs > game.RunsAllowed
+
+	if first, anyone := scoredFirst(game); first && anyone {
+		stats.ScoredFirst++
+
+		if won {
+			stats.ScoredFirstWins++
+		}
+	}
+
+	if won && runsThrough(game.Innings, lateComebackInning) < runsThrough(game.OpponentInnings, lateComebackInning) {
+		stats.LateComebackWins++
+	}
+}
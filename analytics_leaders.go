@@ -0,0 +1,117 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Stats where the lowest value leads unless another order is requested
+var LowerIsBetterStats = map[string]bool{
+	"strikeouts":                true,
+	"caught_stealing":           true,
+	"grounded_into_double_play": true,
+	"left_on_base":              true,
+	"individual_earned_runs":    true,
+	"team_earned_runs":          true,
+	"wild_pitches":              true,
+	"balks":                     true,
+	"errors":                    true,
+	"passed_balls":              true,
+	"k_pct":                     true,
+	"era":                       true,
+	"pitching_bb_pct":           true,
+	"runs_allowed_per_game":     true,
+}
+
+// Adds the numeric fields of a stats struct to values, keyed by their JSON names
+func addStatValues(values map[string]float64, stats interface{}) {
+	value := reflect.ValueOf(stats)
+
+	for i := 0; i < value.NumField(); i++ {
+		name := strings.Split(value.Type().Field(i).Tag.Get("json"), ",")[0]
+
+		switch field := value.Field(i); field.Kind() {
+		case reflect.Int:
+			values[name] = float64(field.Int())
+		case reflect.Float64:
+			values[name] = field.Float()
+		}
+	}
+}
+
+// Counting stats of the Batting, Pitching and Fielding structs, the rate stats as
+// reported and the rate stats at full precision
+func getTeamStatValues(stats *TeamStats) (map[string]float64, map[string]float64, map[string]float64) {
+	counting := make(map[string]float64)
+	rates := make(map[string]float64)
+	exactRates := make(map[string]float64)
+
+	addStatValues(counting, stats.Batting)
+	addStatValues(counting, stats.Pitching)
+	addStatValues(counting, stats.Fielding)
+	addStatValues(rates, computeTeamRateStats(stats))
+	addStatValues(exactRates, computeExactTeamRateStats(stats))
+
+	return counting, rates, exactRates
+}
+
+type TeamLeader struct {
+	Rank  int     `json:"rank"`
+	Tied  bool    `json:"tied"`
+	Team  string  `json:"team"`
+	Games int     `json:"games"`
+	Value float64 `json:"value"`
+	// Unrounded value the teams are ranked on
+	exact float64
+}
+
+// Ranks teams on the stat, tied teams share the rank and the next rank is skipped.
+// Counting stats are divided by games played when perGame is set, rate stats never are.
+// Teams are compared on unrounded values, so close teams are not reported as tied.
+func rankTeams(teams []TeamStats, stat string, perGame bool, ascending bool) ([]TeamLeader, bool) {
+	leaders := []TeamLeader{}
+
+	for i := range teams {
+		counting, rates, exactRates := getTeamStatValues(&teams[i])
+
+		leader := TeamLeader{Team: teams[i].Team, Games: teams[i].Games}
+
+		if value, ok := exactRates[stat]; ok {
+			leader.exact = value
+			leader.Value = rates[stat]
+		} else if value, ok := counting[stat]; ok {
+			leader.exact = value
+			leader.Value = value
+
+			if perGame && teams[i].Games > 0 {
+				leader.exact = value / float64(teams[i].Games)
+				leader.Value = roundTo(leader.exact, 3)
+			}
+		} else {
+			return nil, false
+		}
+
+		leaders = append(leaders, leader)
+	}
+
+	sort.SliceStable(leaders, func(i, j int) bool {
+		if ascending {
+			return leaders[i].exact < leaders[j].exact
+		}
+
+		return leaders[i].exact > leaders[j].exact
+	})
+
+	for i := range leaders {
+		leaders[i].Rank = i + 1
+
+		if i > 0 && leaders[i].exact == leaders[i-1].exact {
+			leaders[i].Rank = leaders[i-1].Rank
+			leaders[i].Tied = true
+			leaders[i-1].Tied = true
+		}
+	}
+
+	return leaders, true
+}
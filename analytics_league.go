@@ -0,0 +1,122 @@
+package main
+
+type LeagueEnvironment struct {
+	Teams int `json:"teams"`
+	// Games played by teams of the league, a game between two of them counts twice
+	TeamGames   int           `json:"team_games"`
+	HomeRunRate float64       `json:"hr_pct"`
+	Rates       TeamRateStats `json:"rates"`
+	Batting     Batting       `json:"batting"`
+}
+
+type PlusStats struct {
+	OPSPlus int `json:"ops_plus"`
+	ERAPlus int `json:"era_plus"`
+	// Run park factor of the team's main home park, 100 is neutral
+	ParkFactor float64 `json:"park_factor"`
+}
+
+func addBatting(batting *Batting, other *Batting) {
+	batting.Runs += other.Runs
+	batting.AtBats += other.AtBats
+	batting.Hits += other.Hits
+	batting.Doubles += other.Doubles
+	batting.Triples += other.Triples
+	batting.HomeRuns += other.HomeRuns
+	batting.RunsBattedIn += other.RunsBattedIn
+	batting.SacrificeHits += other.SacrificeHits
+	batting.SacrificeFlies += other.SacrificeFlies
+	batting.HitByPitch += other.HitByPitch
+	batting.Walks += other.Walks
+	batting.IntentionalWalks += other.IntentionalWalks
+	batting.Strikeouts += other.Strikeouts
+	batting.StolenBases += other.StolenBases
+	batting.CaughtStealing += other.CaughtStealing
+	batting.GroundedIntoDoublePlay += other.GroundedIntoDoublePlay
+	batting.CatcherInterference += other.CatcherInterference
+	batting.LeftOnBase += other.LeftOnBase
+}
+
+// Combined stats of the teams, e.g. a whole league
+func sumTeamStats(teams []TeamStats) TeamStats {
+	var total TeamStats
+
+	for _, team := range teams {
+		total.Games += team.Games
+		total.Wins += team.Wins
+		total.Losses += team.Losses
+
+		addBatting(&total.Batting, &team.Batting)
+		addBatting(&total.OpponentBatting, &team.OpponentBatting)
+
+		total.Pitching.PitchersUsed += team.Pitching.PitchersUsed
+		total.Pitching.IndividualEarnedRuns += team.Pitching.IndividualEarnedRuns
+		total.Pitching.TeamEarnedRuns += team.Pitching.TeamEarnedRuns
+		total.Pitching.WildPitches += team.Pitching.WildPitches
+		total.Pitching.Balks += team.Pitching.Balks
+
+		total.Fielding.Putouts += team.Fielding.Putouts
+		total.Fielding.Assists += team.Fielding.Assists
+		total.Fielding.Errors += team.Fielding.Errors
+		total.Fielding.PassedBalls += team.Fielding.PassedBalls
+		total.Fielding.DoublePlays += team.Fielding.DoublePlays
+		total.Fielding.TriplePlays += team.Fielding.TriplePlays
+	}
+
+	return total
+}
+
+func computeLeagueEnvironment(teams []TeamStats) LeagueEnvironment {
+	total := sumTeamStats(teams)
+
+	return LeagueEnvironment{
+		Teams:       len(teams),
+		TeamGames:   total.Games,
+		HomeRunRate: roundTo(ratio(total.Batting.HomeRuns, plateAppearances(&total.Batting)), 3),
+		Rates:       computeTeamRateStats(&total),
+		Batting:     total.Batting,
+	}
+}
+
+// Full park factor for home games only, none for away games and halved towards
+// neutral for all games as only half of them are at home
+func getParkAdjustment(runsParkFactor float64, side string) float64 {
+	switch side {
+	case "home":
+		return runsParkFactor / 100
+	case "away":
+		return 1
+	}
+
+	return (runsParkFactor/100 + 1) / 2
+}
+
+// OPS+ and ERA+ against the league, 100 is average and higher is better for both.
+// Side is home or away for stats of those games only, empty for all games.
+func computePlusStats(team *TeamStats, league *TeamStats, runsParkFactor float64, side string) PlusStats {
+	if side == "away" {
+		runsParkFactor = neutralParkFactor
+	}
+
+	parkAdjustment := getParkAdjustment(runsParkFactor, side)
+
+	onBase := onBasePct(&team.Batting)
+	leagueOnBase := onBasePct(&league.Batting)
+	slugging := sluggingPct(&team.Batting)
+	leagueSlugging := sluggingPct(&league.Batting)
+
+	plus := PlusStats{ParkFactor: runsParkFactor}
+
+	if leagueOnBase > 0 && leagueSlugging > 0 {
+		plus.OPSPlus = int(roundTo(100*(onBase/leagueOnBase+slugging/leagueSlugging-1)/parkAdjustment, 0))
+	}
+
+	era := ratio(team.Pitching.TeamEarnedRuns, team.Fielding.Putouts)
+	leagueERA := ratio(league.Pitching.TeamEarnedRuns, league.Fielding.Putouts)
+
+	if era > 0 {
+		plus.ERAPlus = int(roundTo(100*leagueERA*parkAdjustment/era, 0))
+	}
+
+	return plus
+}
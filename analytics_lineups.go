@@ -0,0 +1,151 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// Starts a player needs before platoon use is reported
+const platoonMinStarts = 10
+
+// How much more often than the team a player must start against one hand to be platooned
+const platoonShareMargin = 0.25
+
+// A team's starting lineup and the hand (L or R) of the opposing starting pitcher, empty if unknown
+type LineupGame struct {
+	Lineup                []Player
+	OpposingStarterThrows string
+}
+
+type PlayerLineupUsage struct {
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Starts        int            `json:"starts"`
+	Positions     map[string]int `json:"positions"`
+	BattingSlots  [9]int         `json:"batting_slots"`
+	StartsVsLeft  int            `json:"starts_vs_left"`
+	StartsVsRight int            `json:"starts_vs_right"`
+	// "L" or "R" for players started mostly against that hand, empty otherwise
+	Platoon string `json:"platoon"`
+}
+
+type TeamLineupUsage struct {
+	Games int `json:"games"`
+	// Lineups differing in any batter or position
+	DistinctLineups int `json:"distinct_lineups"`
+	// Batting orders differing in any batter, regardless of positions
+	DistinctBattingOrders int                 `json:"distinct_batting_orders"`
+	MostCommonLineup      []Player            `json:"most_common_lineup"`
+	MostCommonLineupGames int                 `json:"most_common_lineup_games"`
+	GamesVsLeft           int                 `json:"games_vs_left"`
+	GamesVsRight          int                 `json:"games_vs_right"`
+	Players               []PlayerLineupUsage `json:"players"`
+}
+
+func getLineupKey(lineup []Player, withPositions bool) string {
+	var parts []string
+
+	for _, player := range lineup {
+		part := player.ID
+
+		if withPositions {
+			part += ":" + player.PositionSymbol
+		}
+
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func getPlatoonSide(player *PlayerLineupUsage, usage *TeamLineupUsage) string {
+	if player.Starts < platoonMinStarts || usage.Games == 0 {
+		return ""
+	}
+
+	if ratio(player.StartsVsLeft, player.Starts)-ratio(usage.GamesVsLeft, usage.Games) >= platoonShareMargin {
+		return "L"
+	}
+
+	if ratio(player.StartsVsRight, player.Starts)-ratio(usage.GamesVsRight, usage.Games) >= platoonShareMargin {
+		return "R"
+	}
+
+	return ""
+}
+
+func computeLineupUsage(games []LineupGame) TeamLineupUsage {
+	usage := TeamLineupUsage{
+		Games:            len(games),
+		MostCommonLineup: []Player{},
+		Players:          []PlayerLineupUsage{},
+	}
+
+	lineups := make(map[string]int)
+	battingOrders := make(map[string]bool)
+	players := make(map[string]int)
+
+	for _, game := range games {
+		key := getLineupKey(game.Lineup, true)
+
+		lineups[key]++
+		battingOrders[getLineupKey(game.Lineup, false)] = true
+
+		if lineups[key] > usage.MostCommonLineupGames {
+			usage.MostCommonLineupGames = lineups[key]
+			usage.MostCommonLineup = game.Lineup
+		}
+
+		switch game.OpposingStarterThrows {
+		case "L":
+			usage.GamesVsLeft++
+		case "R":
+			usage.GamesVsRight++
+		}
+
+		for slot, player := range game.Lineup {
+			if player.ID == "" || slot >= 9 {
+				continue
+			}
+
+			i, ok := players[player.ID]
+
+			if !ok {
+				i = len(usage.Players)
+				players[player.ID] = i
+
+				usage.Players = append(usage.Players, PlayerLineupUsage{
+					ID:        player.ID,
+					Name:      player.Name,
+					Positions: make(map[string]int),
+				})
+			}
+
+			playerUsage := &usage.Players[i]
+
+			playerUsage.Starts++
+			playerUsage.Positions[player.PositionSymbol]++
+			playerUsage.BattingSlots[slot]++
+
+			switch game.OpposingStarterThrows {
+			case "L":
+				playerUsage.StartsVsLeft++
+			case "R":
+				playerUsage.StartsVsRight++
+			}
+		}
+	}
+
+	usage.DistinctLineups = len(lineups)
+	usage.DistinctBattingOrders = len(battingOrders)
+
+	for i := range usage.Players {
+		usage.Players[i].Platoon = getPlatoonSide(&usage.Players[i], &usage)
+	}
+
+	sort.SliceStable(usage.Players, func(i, j int) bool {
+		return usage.Players[i].Starts > usage.Players[j].Starts
+	})
+
+	return usage
+}
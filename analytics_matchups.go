@@ -0,0 +1,97 @@
+package main
+
+type MatchupRecord struct {
+	Games       int `json:"games"`
+	Wins        int `json:"wins"`
+	Losses      int `json:"losses"`
+	Ties        int `json:"ties"`
+	RunsScored  int `json:"runs_scored"`
+	RunsAllowed int `json:"runs_allowed"`
+}
+
+func (record *MatchupRecord) add(scored int, allowed int, winner string, team string) {
+	record.Games++
+	record.RunsScored += scored
+	record.RunsAllowed += allowed
+
+	if winner == "" {
+		record.Ties++
+	} else if winner == team {
+		record.Wins++
+	} else {
+		record.Losses++
+	}
+}
+
+type MatchupStreak struct {
+	Team   string `json:"team"`
+	Length int    `json:"length"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+type MatchupPark struct {
+	ParkID string        `json:"venue_id"`
+	Name   string        `json:"name"`
+	Record MatchupRecord `json:"record"`
+}
+
+// Records are from the point of view of team A
+type Matchup struct {
+	Record        MatchupRecord `json:"record"`
+	LongestStreak MatchupStreak `json:"longest_streak"`
+	CurrentStreak MatchupStreak `json:"current_streak"`
+	Parks         []MatchupPark `json:"venues"`
+}
+
+// Games must be in chronological order. Ties end a streak.
+func computeMatchup(teamA string, games []GameScore) Matchup {
+	matchup := Matchup{
+		Parks: []MatchupPark{},
+	}
+
+	parkIndexes := make(map[string]int)
+
+	var current MatchupStreak
+
+	for _, game := range games {
+		scored, allowed, winner := game.resultFor(teamA)
+		date := game.Date.Format("2006-01-02")
+
+		matchup.Record.add(scored, allowed, winner, teamA)
+
+		i, ok := parkIndexes[game.ParkID]
+
+		if !ok {
+			i = len(matchup.Parks)
+			parkIndexes[game.ParkID] = i
+
+			name := ""
+
+			if park, ok := PARKS[game.ParkID]; ok {
+				name = park.Name
+			}
+
+			matchup.Parks = append(matchup.Parks, MatchupPark{ParkID: game.ParkID, Name: name})
+		}
+
+		matchup.Parks[i].Record.add(scored, allowed, winner, teamA)
+
+		if winner != "" && winner == current.Team {
+			current.Length++
+			current.To = date
+		} else if winner != "" {
+			current = MatchupStreak{Team: winner, Length: 1, From: date, To: date}
+		} else {
+			current = MatchupStreak{}
+		}
+
+		if current.Length > matchup.LongestStreak.Length {
+			matchup.LongestStreak = current
+		}
+	}
+
+	matchup.CurrentStreak = current
+
+	return matchup
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Winning margin, in runs, from which a game is a blowout
+const blowoutMargin = 10
+
+// Deficit, in runs, the winner must have overcome for a game to be a comeback
+const comebackDeficit = 5
+
+// Outs in a full nine inning game
+const regulationOuts = 54
+
+var NotableGameChecks = map[string]func(game *Game) bool{
+	"no_hitter":     isNoHitter,
+	"shutout":       isShutout,
+	"walk_off":      isWalkOff,
+	"extra_innings": isExtraInnings,
+	"comeback":      isComeback,
+	"blowout":       isBlowout,
+}
+
+// Runs per inning from a line score such as "00100(10)00x", unplayed innings are -1
+func parseLineScore(lineScore string) []int {
+	var innings []int
+
+	for i := 0; i < len(lineScore); i++ {
+		switch c := lineScore[i]; {
+		case c == 'x' || c == 'X':
+			innings = append(innings, -1)
+		case c == '(':
+			end := strings.IndexByte(lineScore[i:], ')')
+
+			if end < 0 {
+				return nil
+			}
+
+			runs, err := strconv.Atoi(lineScore[i+1 : i+end])
+
+			if err != nil {
+				return nil
+			}
+
+			innings = append(innings, runs)
+			i += end
+		case c >= '0' && c <= '9':
+			innings = append(innings, int(c-'0'))
+		default:
+			return nil
+		}
+	}
+
+	return innings
+}
+
+// Since 1991 the pitchers must get at least 27 outs, so a visiting team losing
+// without allowing a hit in 8 innings does not count. Missing hits are stored as -1.
+func isNoHitter(game *Game) bool {
+	return (game.VisitingH == 0 && game.GameLengthInOuts >= regulationOuts-3) ||
+		(game.HomeH == 0 && game.GameLengthInOuts >= regulationOuts)
+}
+
+func isShutout(game *Game) bool {
+	return (game.VisitingTeamScore == 0 && game.HomeTeamScore > 0) ||
+		(game.HomeTeamScore == 0 && game.VisitingTeamScore > 0)
+}
+
+// Both games of 2020 and 2021 doubleheaders were scheduled for seven innings
+func getScheduledInnings(season int, numberOfGame string) int {
+	if (season == 2020 || season == 2021) && numberOfGame != "0" {
+		return 7
+	}
+
+	return 9
+}
+
+// Innings come from the line score, the length in outs is used when it is missing
+func isExtraInnings(game *Game) bool {
+	scheduled := getScheduledInnings(game.Date.Year(), game.NumberOfGame)
+
+	if innings := parseLineScore(game.VisitingLineScore); len(innings) > 0 {
+		return len(innings) > scheduled
+	}
+
+	return game.GameLengthInOuts > 6*scheduled
+}
+
+func isBlowout(game *Game) bool {
+	margin := game.VisitingTeamScore - game.HomeTeamScore
+
+	return margin >= blowoutMargin || -margin >= blowoutMargin
+}
+
+// Home team won in its last turn at bat after not leading when it started
+func isWalkOff(game *Game) bool {
+	if game.HomeTeamScore <= game.VisitingTeamScore {
+		return false
+	}
+
+	home := parseLineScore(game.HomeLineScore)
+
+	if len(home) == 0 || home[len(home)-1] <= 0 {
+		return false
+	}
+
+	return game.HomeTeamScore-home[len(home)-1] <= game.VisitingTeamScore
+}
+
+// Winner trailed by at least comebackDeficit runs after some half-inning
+func isComeback(game *Game) bool {
+	visiting := parseLineScore(game.VisitingLineScore)
+	home := parseLineScore(game.HomeLineScore)
+
+	if len(visiting) == 0 || len(home) == 0 || game.VisitingTeamScore == game.HomeTeamScore {
+		return false
+	}
+
+	visitingWon := game.VisitingTeamScore > game.HomeTeamScore
+	visitingRuns := 0
+	homeRuns := 0
+	deficit := 0
+
+	trackDeficit := func() {
+		behind := visitingRuns - homeRuns
+
+		if visitingWon {
+			behind = -behind
+		}
+
+		if behind > deficit {
+			deficit = behind
+		}
+	}
+
+	for i := range visiting {
+		visitingRuns += visiting[i]
+		trackDeficit()
+
+		if i < len(home) && home[i] > 0 {
+			homeRuns += home[i]
+			trackDeficit()
+		}
+	}
+
+	return deficit >= comebackDeficit
+}
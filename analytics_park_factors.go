@@ -0,0 +1,115 @@
+package main
+
+import (
+	"math"
+)
+
+type ParkStatTotals struct {
+	Games      int
+	Runs       int
+	Hits       int
+	HomeRuns   int
+	Doubles    int
+	Triples    int
+	Walks      int
+	Strikeouts int
+}
+
+func (totals *ParkStatTotals) add(other ParkStatTotals) {
+	totals.Games += other.Games
+	totals.Runs += other.Runs
+	totals.Hits += other.Hits
+	totals.HomeRuns += other.HomeRuns
+	totals.Doubles += other.Doubles
+	totals.Triples += other.Triples
+	totals.Walks += other.Walks
+	totals.Strikeouts += other.Strikeouts
+}
+
+// Stats of both teams in the home team's games at the park and in its road games elsewhere
+type ParkSplit struct {
+	Season int
+	Home   ParkStatTotals
+	Road   ParkStatTotals
+}
+
+type ParkFactorValues struct {
+	Runs       *float64 `json:"runs"`
+	Hits       *float64 `json:"hits"`
+	HomeRuns   *float64 `json:"home_runs"`
+	Doubles    *float64 `json:"doubles"`
+	Triples    *float64 `json:"triples"`
+	Walks      *float64 `json:"walks"`
+	Strikeouts *float64 `json:"strikeouts"`
+}
+
+type ParkFactors struct {
+	Season     int              `json:"season"`
+	FromSeason int              `json:"from_season"`
+	HomeGames  int              `json:"home_games"`
+	RoadGames  int              `json:"road_games"`
+	Factors    ParkFactorValues `json:"factors"`
+}
+
+// 100 is neutral, nil when the stat was not recorded
+func parkFactor(home int, homeGames int, road int, roadGames int) *float64 {
+	if home <= 0 || road <= 0 || homeGames == 0 || roadGames == 0 {
+		return nil
+	}
+
+	factor := (float64(home) / float64(homeGames)) / (float64(road) / float64(roadGames)) * 100
+	factor = math.Round(factor*10) / 10
+
+	return &factor
+}
+
+// Splits must be sorted by season. Several splits in one season (parks shared by
+// two home teams) are combined. Each season's factors use the splits from the
+// previous years-1 seasons as well.
+func computeParkFactors(splits []ParkSplit, years int) []ParkFactors {
+	var seasons []ParkSplit
+
+	for _, split := range splits {
+		last := len(seasons) - 1
+
+		if last >= 0 && seasons[last].Season == split.Season {
+			seasons[last].Home.add(split.Home)
+			seasons[last].Road.add(split.Road)
+		} else {
+			seasons = append(seasons, split)
+		}
+	}
+
+	factors := []ParkFactors{}
+
+	for i, season := range seasons {
+		var home ParkStatTotals
+		var road ParkStatTotals
+
+		fromSeason := season.Season
+
+		for j := i; j >= 0 && seasons[j].Season > season.Season-years; j-- {
+			home.add(seasons[j].Home)
+			road.add(seasons[j].Road)
+			fromSeason = seasons[j].Season
+		}
+
+		factors = append(factors, ParkFactors{
+			Season:     season.Season,
+			FromSeason: fromSeason,
+			HomeGames:  home.Games,
+			RoadGames:  road.Games,
+			Factors: ParkFactorValues{
+				Runs:       parkFactor(home.Runs, home.Games, road.Runs, road.Games),
+				Hits:       parkFactor(home.Hits, home.Games, road.Hits, road.Games),
+				HomeRuns:   parkFactor(home.HomeRuns, home.Games, road.HomeRuns, road.Games),
+				Doubles:    parkFactor(home.Doubles, home.Games, road.Doubles, road.Games),
+				Triples:    parkFactor(home.Triples, home.Games, road.Triples, road.Games),
+				Walks:      parkFactor(home.Walks, home.Games, road.Walks, road.Games),
+				Strikeouts: parkFactor(home.Strikeouts, home.Games, road.Strikeouts, road.Games),
+			},
+		})
+	}
+
+	return factors
+}
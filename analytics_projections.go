@@ -0,0 +1,283 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Games of .500 ball added to a team's expected record before turning it into a rating
+const projectionRegressionGames = 40
+
+type PlayoffFormat struct {
+	// Division winners qualify, otherwise only the league winner does
+	Divisions bool
+	// Best remaining records per league that qualify
+	WildCards int
+}
+
+// 1994 had no postseason and is treated like 1995. The 2020 format
+// (top two of every division and two more) is approximated with five wild cards.
+func getPlayoffFormat(season int) PlayoffFormat {
+	switch {
+	case season < 1969:
+		return PlayoffFormat{Divisions: false, WildCards: 0}
+	case season < 1994:
+		return PlayoffFormat{Divisions: true, WildCards: 0}
+	case season < 2012:
+		return PlayoffFormat{Divisions: true, WildCards: 1}
+	case season == 2020:
+		return PlayoffFormat{Divisions: true, WildCards: 5}
+	case season < 2022:
+		return PlayoffFormat{Divisions: true, WildCards: 2}
+	default:
+		return PlayoffFormat{Divisions: true, WildCards: 3}
+	}
+}
+
+type ProjectionTeam struct {
+	Team     string
+	League   string
+	Division string
+	Wins     int
+	Losses   int
+	Rating   float64
+}
+
+type TeamProjection struct {
+	Team            string  `json:"team"`
+	League          string  `json:"league"`
+	Division        string  `json:"division"`
+	Wins            int     `json:"wins"`
+	Losses          int     `json:"losses"`
+	Rating          float64 `json:"rating"`
+	ProjectedWins   float64 `json:"projected_wins"`
+	ProjectedLosses float64 `json:"projected_losses"`
+	DivisionOdds    float64 `json:"division_odds"`
+	LeagueOdds      float64 `json:"league_best_record_odds"`
+	PlayoffOdds     float64 `json:"playoff_odds"`
+}
+
+// Elo-like rating of a winning percentage, 1500 for .500
+func pctToRating(pct float64) float64 {
+	pct = math.Max(0.01, math.Min(0.99, pct))
+
+	return 1500 + 400*math.Log10(pct/(1-pct))
+}
+
+// Rating from the Pythagenpat record, regressed towards .500
+func getRunDifferentialRating(totals *TeamSeasonTotals) float64 {
+	scored := float64(totals.Batting.Runs)
+	allowed := float64(totals.OpponentBatting.Runs)
+	decisions := float64(totals.Wins + totals.Losses)
+
+	pct := winPct(scored, allowed, pythagenpatExponent(scored, allowed, totals.Games))
+	regressed := (pct*decisions + 0.5*projectionRegressionGames) / (decisions + projectionRegressionGames)
+
+	return pctToRating(regressed)
+}
+
+// Best winning percentage among the team indexes, ties are broken at random
+func bestRecord(indexes []int, wins []int, losses []int, rng *rand.Rand) int {
+	best := -1
+	bestPct := 0.0
+	tied := 0
+
+	for _, i := range indexes {
+		pct := winningPct(wins[i], losses[i])
+
+		if best < 0 || pct > bestPct {
+			best = i
+			bestPct = pct
+			tied = 1
+		} else if pct == bestPct {
+			tied++
+
+			if rng.Intn(tied) == 0 {
+				best = i
+			}
+		}
+	}
+
+	return best
+}
+
+func groupTeamIndexes(teams []ProjectionTeam, key func(team *ProjectionTeam) string) [][]int {
+	var keys []string
+
+	groups := make(map[string][]int)
+
+	for i := range teams {
+		k := key(&teams[i])
+
+		if _, ok := groups[k]; !ok {
+			keys = append(keys, k)
+		}
+
+		groups[k] = append(groups[k], i)
+	}
+
+	sort.Strings(keys)
+
+	var indexes [][]int
+
+	for _, k := range keys {
+		indexes = append(indexes, groups[k])
+	}
+
+	return indexes
+}
+
+// Plays the remaining games the given number of times and returns the projections with
+// the number of games simulated. Win probabilities come from the ratings, records are
+// compared by winning percentage as teams can end up playing different numbers of games.
+func simulateSeason(teams []ProjectionTeam, games []RemainingGame, format PlayoffFormat,
+	homeAdvantage float64, simulations int, rng *rand.Rand) ([]TeamProjection, int) {
+	indexes := make(map[string]int)
+
+	for i, team := range teams {
+		indexes[team.Team] = i
+	}
+
+	// Indexes of teams by league and by division, in a fixed order so that seeded runs repeat
+	leagues := groupTeamIndexes(teams, func(team *ProjectionTeam) string {
+		return team.League
+	})
+	divisions := groupTeamIndexes(teams, func(team *ProjectionTeam) string {
+		return team.League + " " + team.Division
+	})
+
+	// Probability of the home team winning each game, games with unknown teams are skipped
+	type simulatedGame struct {
+		visiting        int
+		home            int
+		homeProbability float64
+	}
+
+	var schedule []simulatedGame
+
+	for _, game := range games {
+		visiting, visitingOk := indexes[game.VisitingTeam]
+		home, homeOk := indexes[game.HomeTeam]
+
+		if visitingOk && homeOk {
+			schedule = append(schedule, simulatedGame{
+				visiting:        visiting,
+				home:            home,
+				homeProbability: eloWinProbability(teams[home].Rating+homeAdvantage, teams[visiting].Rating),
+			})
+		}
+	}
+
+	totalWins := make([]int, len(teams))
+	totalLosses := make([]int, len(teams))
+	divisionTitles := make([]int, len(teams))
+	leagueTitles := make([]int, len(teams))
+	playoffs := make([]int, len(teams))
+
+	wins := make([]int, len(teams))
+	losses := make([]int, len(teams))
+
+	for s := 0; s < simulations; s++ {
+		for i, team := range teams {
+			wins[i] = team.Wins
+			losses[i] = team.Losses
+		}
+
+		for _, game := range schedule {
+			if rng.Float64() < game.homeProbability {
+				wins[game.home]++
+				losses[game.visiting]++
+			} else {
+				wins[game.visiting]++
+				losses[game.home]++
+			}
+		}
+
+		qualified := make([]bool, len(teams))
+
+		for _, members := range leagues {
+			winner := bestRecord(members, wins, losses, rng)
+			leagueTitles[winner]++
+
+			if !format.Divisions {
+				qualified[winner] = true
+			}
+		}
+
+		for _, members := range divisions {
+			winner := bestRecord(members, wins, losses, rng)
+			divisionTitles[winner]++
+
+			if format.Divisions {
+				qualified[winner] = true
+			}
+		}
+
+		for _, members := range leagues {
+			for w := 0; w < format.WildCards; w++ {
+				var remaining []int
+
+				for _, i := range members {
+					if !qualified[i] {
+						remaining = append(remaining, i)
+					}
+				}
+
+				if len(remaining) == 0 {
+					break
+				}
+
+				qualified[bestRecord(remaining, wins, losses, rng)] = true
+			}
+		}
+
+		for i := range teams {
+			totalWins[i] += wins[i]
+			totalLosses[i] += losses[i]
+
+			if qualified[i] {
+				playoffs[i]++
+			}
+		}
+	}
+
+	projections := []TeamProjection{}
+
+	for i, team := range teams {
+		share := func(count int) float64 {
+			return roundTo(float64(count)/float64(simulations), 3)
+		}
+
+		projections = append(projections, TeamProjection{
+			Team:            team.Team,
+			League:          team.League,
+			Division:        team.Division,
+			Wins:            team.Wins,
+			Losses:          team.Losses,
+			Rating:          roundTo(team.Rating, 1),
+			ProjectedWins:   roundTo(float64(totalWins[i])/float64(simulations), 1),
+			ProjectedLosses: roundTo(float64(totalLosses[i])/float64(simulations), 1),
+			DivisionOdds:    share(divisionTitles[i]),
+			LeagueOdds:      share(leagueTitles[i]),
+			PlayoffOdds:     share(playoffs[i]),
+		})
+	}
+
+	sort.SliceStable(projections, func(i, j int) bool {
+		a := projections[i]
+		b := projections[j]
+
+		if a.League != b.League {
+			return a.League < b.League
+		}
+
+		if a.Division != b.Division {
+			return a.Division < b.Division
+		}
+
+		return a.ProjectedWins > b.ProjectedWins
+	})
+
+	return projections, len(schedule)
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"sort"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Starts a pitcher needs to be counted as a member of the rotation
+const rotationMinStarts = 5
+
+// Days of rest between starts in a five-man rotation
+const standardDaysRest = 4
+
+type TeamStart struct {
+	Date         time.Time
+	NumberOfGame string
+	GameNumber   int
+	Pitcher      Person
+	// Pitcher's previous start in the season for any team, like the game lineups use
+	PreviousStart pq.NullTime
+}
+
+type RotationStart struct {
+	Date         string `json:"date"`
+	NumberOfGame string `json:"number_of_game"`
+	GameNumber   int    `json:"game_number"`
+	Pitcher      Person `json:"pitcher"`
+	// Nil for the pitcher's first start of the season, starts for other teams count
+	DaysRest     *int `json:"days_rest"`
+	RotationSlot int  `json:"rotation_slot"`
+}
+
+type RotationPitcher struct {
+	Pitcher         Person  `json:"pitcher"`
+	Starts          int     `json:"starts"`
+	RotationSlot    int     `json:"rotation_slot"`
+	AverageDaysRest float64 `json:"average_days_rest"`
+	// Starts on fewer or more days of rest than the standard four
+	StartsOnShortRest int `json:"starts_on_short_rest"`
+	StartsOnExtraRest int `json:"starts_on_extra_rest"`
+}
+
+type TeamRotation struct {
+	// Pitchers with enough starts, in rotation order
+	Rotation []Person          `json:"rotation"`
+	Pitchers []RotationPitcher `json:"pitchers"`
+	Starts   []RotationStart   `json:"starts"`
+}
+
+// Full days between two starts, zero for both games of a doubleheader
+func getDaysRest(previous time.Time, date time.Time) int {
+	days := int(date.Sub(previous).Hours()/24+0.5) - 1
+
+	if days < 0 {
+		return 0
+	}
+
+	return days
+}
+
+func getMostCommonSlot(slots map[int]int) int {
+	slot := 0
+
+	for s, count := range slots {
+		if count > slots[slot] || (count == slots[slot] && s < slot) {
+			slot = s
+		}
+	}
+
+	return slot
+}
+
+// Starts must be in chronological order. A turn through the rotation ends when a
+// pitcher who already started in it starts again, and each start's slot is its
+// position in the turn. Pitchers are ordered by the slot they started in most often.
+func computeRotation(starts []TeamStart) TeamRotation {
+	rotation := TeamRotation{
+		Rotation: []Person{},
+		Pitchers: []RotationPitcher{},
+		Starts:   []RotationStart{},
+	}
+
+	pitchers := make(map[string]int)
+	slots := make(map[string]map[int]int)
+	daysRestTotals := make(map[string]int)
+	daysRestCounts := make(map[string]int)
+	turn := make(map[string]bool)
+
+	for _, start := range starts {
+		id := start.Pitcher.ID
+
+		if id == "" {
+			continue
+		}
+
+		if turn[id] {
+			turn = make(map[string]bool)
+		}
+
+		turn[id] = true
+
+		i, ok := pitchers[id]
+
+		if !ok {
+			i = len(rotation.Pitchers)
+			pitchers[id] = i
+			slots[id] = make(map[int]int)
+
+			rotation.Pitchers = append(rotation.Pitchers, RotationPitcher{Pitcher: start.Pitcher})
+		}
+
+		pitcher := &rotation.Pitchers[i]
+
+		rotationStart := RotationStart{
+			Date:         start.Date.Format("2006-01-02"),
+			NumberOfGame: start.NumberOfGame,
+			GameNumber:   start.GameNumber,
+			Pitcher:      start.Pitcher,
+			RotationSlot: len(turn),
+		}
+
+		if start.PreviousStart.Valid {
+			daysRest := getDaysRest(start.PreviousStart.Time, start.Date)
+			rotationStart.DaysRest = &daysRest
+
+			daysRestTotals[id] += daysRest
+			daysRestCounts[id]++
+
+			if daysRest < standardDaysRest {
+				pitcher.StartsOnShortRest++
+			} else if daysRest > standardDaysRest {
+				pitcher.StartsOnExtraRest++
+			}
+		}
+
+		slots[id][len(turn)]++
+		pitcher.Starts++
+
+		rotation.Starts = append(rotation.Starts, rotationStart)
+	}
+
+	for i := range rotation.Pitchers {
+		pitcher := &rotation.Pitchers[i]
+		id := pitcher.Pitcher.ID
+
+		pitcher.RotationSlot = getMostCommonSlot(slots[id])
+		pitcher.AverageDaysRest = roundTo(ratio(daysRestTotals[id], daysRestCounts[id]), 2)
+	}
+
+	sort.SliceStable(rotation.Pitchers, func(i, j int) bool {
+		return rotation.Pitchers[i].Starts > rotation.Pitchers[j].Starts
+	})
+
+	var members []RotationPitcher
+
+	for _, pitcher := range rotation.Pitchers {
+		if pitcher.Starts >= rotationMinStarts {
+			members = append(members, pitcher)
+		}
+	}
+
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].RotationSlot < members[j].RotationSlot
+	})
+
+	for _, pitcher := range members {
+		rotation.Rotation = append(rotation.Rotation, pitcher.Pitcher)
+	}
+
+	return rotation
+}
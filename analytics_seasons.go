@@ -0,0 +1,39 @@
+package main
+
+func winningPct(wins int, losses int) float64 {
+	return ratio(wins, wins+losses)
+}
+
+// Teams with the best winning percentage in each league, all of them when tied.
+// Teams must be ordered by league.
+func findLeagueChampions(teams []TeamSeasonTotals) []TeamSeasonTotals {
+	champions := []TeamSeasonTotals{}
+
+	start := 0
+
+	for start < len(teams) {
+		end := start
+
+		for end < len(teams) && teams[end].League == teams[start].League {
+			end++
+		}
+
+		best := -1.0
+
+		for _, team := range teams[start:end] {
+			if pct := winningPct(team.Wins, team.Losses); pct > best {
+				best = pct
+			}
+		}
+
+		for _, team := range teams[start:end] {
+			if winningPct(team.Wins, team.Losses) == best {
+				champions = append(champions, team)
+			}
+		}
+
+		start = end
+	}
+
+	return champions
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"time"
+)
+
+// Games more than this many days apart are never part of the same series
+const maxDaysBetweenSeriesGames = 2
+
+type Series struct {
+	ID       string
+	Opponent string
+	ParkID   string
+	HomeTeam string
+	From     time.Time
+	To       time.Time
+	Record   MatchupRecord
+	Games    []GameScore
+}
+
+// "won", "lost" or "split", from the point of view of the team
+func (series *Series) Result() string {
+	if series.Record.Wins > series.Record.Losses {
+		return "won"
+	} else if series.Record.Losses > series.Record.Wins {
+		return "lost"
+	}
+
+	return "split"
+}
+
+// A sweep needs at least two games, all won by the same team
+func (series *Series) Swept() bool {
+	record := series.Record
+
+	return record.Games >= 2 && (record.Wins == record.Games || record.Losses == record.Games)
+}
+
+type SeriesSummary struct {
+	Series int `json:"series"`
+	Won    int `json:"won"`
+	Lost   int `json:"lost"`
+	Split  int `json:"split"`
+	// Series in which the team won every game
+	Sweeps int `json:"sweeps"`
+	// Series in which the team lost every game
+	Swept int `json:"swept"`
+}
+
+// Series IDs are the home team, yyyymmdd of the first game and the visiting team, e.g. BOS20180403TBA
+func getSeriesID(game *GameScore) string {
+	return game.HomeTeam + game.Date.Format("20060102") + game.VisitingTeam
+}
+
+func continuesSeries(series *Series, game *GameScore, team string) bool {
+	if series.Opponent != game.opponentOf(team) || series.ParkID != game.ParkID {
+		return false
+	}
+
+	days := game.Date.Sub(series.To).Hours() / 24
+
+	return days <= maxDaysBetweenSeriesGames
+}
+
+// Games must be in chronological order. Doubleheaders fall into the same series
+// and a single off-day between games does not end one.
+func detectSeries(team string, games []GameScore) []Series {
+	series := []Series{}
+
+	for _, game := range games {
+		last := len(series) - 1
+
+		if last < 0 || !continuesSeries(&series[last], &game, team) {
+			series = append(series, Series{
+				ID:       getSeriesID(&game),
+				Opponent: game.opponentOf(team),
+				ParkID:   game.ParkID,
+				HomeTeam: game.HomeTeam,
+				From:     game.Date,
+				Games:    []GameScore{},
+			})
+			last++
+		}
+
+		scored, allowed, winner := game.resultFor(team)
+
+		series[last].To = game.Date
+		series[last].Record.add(scored, allowed, winner, team)
+		series[last].Games = append(series[last].Games, game)
+	}
+
+	return series
+}
+
+func summarizeSeries(series []Series) SeriesSummary {
+	summary := SeriesSummary{Series: len(series)}
+
+	for _, s := range series {
+		switch s.Result() {
+		case "won":
+			summary.Won++
+
+			if s.Swept() {
+				summary.Sweeps++
+			}
+		case "lost":
+			summary.Lost++
+
+			if s.Swept() {
+				summary.Swept++
+			}
+		default:
+			summary.Split++
+		}
+	}
+
+	return summary
+}
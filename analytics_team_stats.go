@@ -0,0 +1,100 @@
+package main
+
+import (
+	"math"
+)
+
+type TeamRateStats struct {
+	Average               float64 `json:"avg"`
+	OnBase                float64 `json:"obp"`
+	Slugging              float64 `json:"slg"`
+	OnBasePlusSlugging    float64 `json:"ops"`
+	StrikeoutRate         float64 `json:"k_pct"`
+	WalkRate              float64 `json:"bb_pct"`
+	EarnedRunAverage      float64 `json:"era"`
+	FieldingPct           float64 `json:"fielding_pct"`
+	PitchingStrikeoutRate float64 `json:"pitching_k_pct"`
+	PitchingWalkRate      float64 `json:"pitching_bb_pct"`
+	RunsPerGame           float64 `json:"runs_per_game"`
+	RunsAllowedPerGame    float64 `json:"runs_allowed_per_game"`
+}
+
+func ratio(numerator int, denominator int) float64 {
+	if denominator == 0 {
+		return 0
+	}
+
+	return float64(numerator) / float64(denominator)
+}
+
+func roundTo(value float64, places int) float64 {
+	shift := math.Pow(10, float64(places))
+
+	return math.Round(value*shift) / shift
+}
+
+func plateAppearances(batting *Batting) int {
+	return batting.AtBats + batting.Walks + batting.HitByPitch + batting.SacrificeFlies +
+		batting.SacrificeHits + batting.CatcherInterference
+}
+
+func totalBases(batting *Batting) int {
+	singles := batting.Hits - batting.Doubles - batting.Triples - batting.HomeRuns
+
+	return singles + 2*batting.Doubles + 3*batting.Triples + 4*batting.HomeRuns
+}
+
+func onBasePct(batting *Batting) float64 {
+	return ratio(batting.Hits+batting.Walks+batting.HitByPitch,
+		batting.AtBats+batting.Walks+batting.HitByPitch+batting.SacrificeFlies)
+}
+
+func sluggingPct(batting *Batting) float64 {
+	return ratio(totalBases(batting), batting.AtBats)
+}
+
+// Outs recorded by a team's defence are its putouts, which gives innings pitched for ERA.
+// Values are not rounded, so they can be used to compare teams.
+func computeExactTeamRateStats(stats *TeamStats) TeamRateStats {
+	batting := &stats.Batting
+	opponent := &stats.OpponentBatting
+
+	onBase := onBasePct(batting)
+	slugging := sluggingPct(batting)
+
+	chances := stats.Fielding.Putouts + stats.Fielding.Assists + stats.Fielding.Errors
+
+	return TeamRateStats{
+		Average:               ratio(batting.Hits, batting.AtBats),
+		OnBase:                onBase,
+		Slugging:              slugging,
+		OnBasePlusSlugging:    onBase + slugging,
+		StrikeoutRate:         ratio(batting.Strikeouts, plateAppearances(batting)),
+		WalkRate:              ratio(batting.Walks, plateAppearances(batting)),
+		EarnedRunAverage:      27 * ratio(stats.Pitching.TeamEarnedRuns, stats.Fielding.Putouts),
+		FieldingPct:           ratio(stats.Fielding.Putouts+stats.Fielding.Assists, chances),
+		PitchingStrikeoutRate: ratio(opponent.Strikeouts, plateAppearances(opponent)),
+		PitchingWalkRate:      ratio(opponent.Walks, plateAppearances(opponent)),
+		RunsPerGame:           ratio(batting.Runs, stats.Games),
+		RunsAllowedPerGame:    ratio(opponent.Runs, stats.Games),
+	}
+}
+
+func computeTeamRateStats(stats *TeamStats) TeamRateStats {
+	rates := computeExactTeamRateStats(stats)
+
+	return TeamRateStats{
+		Average:               roundTo(rates.Average, 3),
+		OnBase:                roundTo(rates.OnBase, 3),
+		Slugging:              roundTo(rates.Slugging, 3),
+		OnBasePlusSlugging:    roundTo(rates.OnBasePlusSlugging, 3),
+		StrikeoutRate:         roundTo(rates.StrikeoutRate, 3),
+		WalkRate:              roundTo(rates.WalkRate, 3),
+		EarnedRunAverage:      roundTo(rates.EarnedRunAverage, 2),
+		FieldingPct:           roundTo(rates.FieldingPct, 3),
+		PitchingStrikeoutRate: roundTo(rates.PitchingStrikeoutRate, 3),
+		PitchingWalkRate:      roundTo(rates.PitchingWalkRate, 3),
+		RunsPerGame:           roundTo(rates.RunsPerGame, 2),
+		RunsAllowedPerGame:    roundTo(rates.RunsAllowedPerGame, 2),
+	}
+}
@@ -0,0 +1,552 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+func TestComputeParkFactors(t *testing.T) {
+	splits := []ParkSplit{
+		{Season: 2016, Home: ParkStatTotals{Games: 81, Runs: 810, HomeRuns: 162}, Road: ParkStatTotals{Games: 81, Runs: 729, HomeRuns: 162}},
+		{Season: 2017, Home: ParkStatTotals{Games: 40, Runs: 400}, Road: ParkStatTotals{Games: 40, Runs: 400}},
+		{Season: 2017, Home: ParkStatTotals{Games: 41, Runs: 410}, Road: ParkStatTotals{Games: 41, Runs: 410}},
+		{Season: 2018, Home: ParkStatTotals{Games: 81, Runs: 729}, Road: ParkStatTotals{Games: 81, Runs: 810}},
+	}
+
+	factors := computeParkFactors(splits, 1)
+
+	assertEqual(t, len(factors), 3)
+	assertEqual(t, *factors[0].Factors.Runs, 111.1)
+	assertEqual(t, *factors[0].Factors.HomeRuns, 100.0)
+	assertEqual(t, *factors[1].Factors.Runs, 100.0)
+	assertEqual(t, factors[1].HomeGames, 81)
+	assertEqual(t, factors[1].Factors.HomeRuns == nil, true)
+	assertEqual(t, *factors[2].Factors.Runs, 90.0)
+
+	factors = computeParkFactors(splits, 3)
+
+	assertEqual(t, factors[2].FromSeason, 2016)
+	assertEqual(t, factors[2].HomeGames, 243)
+	assertEqual(t, *factors[2].Factors.Runs, 100.0)
+}
+
+func game(date string, visiting string, home string, visitingScore int, homeScore int, park string) GameScore {
+	parsed, _ := time.Parse("2006-01-02", date)
+
+	return GameScore{Date: parsed, VisitingTeam: visiting, HomeTeam: home, VisitingTeamScore: visitingScore, HomeTeamScore: homeScore, ParkID: park}
+}
+
+func TestComputeMatchup(t *testing.T) {
+	PARKS = make(map[string]*RawPark)
+
+	matchup := computeMatchup("BOS", []GameScore{
+		game("2018-04-10", "NYA", "BOS", 1, 14, "BOS07"),
+		game("2018-04-11", "NYA", "BOS", 10, 7, "BOS07"),
+		game("2018-05-08", "BOS", "NYA", 2, 3, "NYC21"),
+		game("2018-05-09", "BOS", "NYA", 3, 2, "NYC21"),
+		game("2018-05-10", "BOS", "NYA", 5, 4, "NYC21"),
+	})
+
+	assertEqual(t, matchup.Record.Wins, 3)
+	assertEqual(t, matchup.Record.Losses, 2)
+	assertEqual(t, matchup.Record.RunsScored, 31)
+	assertEqual(t, matchup.Record.RunsAllowed, 20)
+	assertEqual(t, matchup.LongestStreak.Team, "NYA")
+	assertEqual(t, matchup.LongestStreak.Length, 2)
+	assertEqual(t, matchup.CurrentStreak.Team, "BOS")
+	assertEqual(t, matchup.CurrentStreak.From, "2018-05-09")
+	assertEqual(t, len(matchup.Parks), 2)
+	assertEqual(t, matchup.Parks[1].Record.Wins, 2)
+}
+
+func TestDetectSeries(t *testing.T) {
+	series := detectSeries("BOS", []GameScore{
+		game("2018-04-10", "NYA", "BOS", 1, 14, "BOS07"),
+		game("2018-04-11", "NYA", "BOS", 10, 7, "BOS07"),
+		game("2018-04-11", "NYA", "BOS", 2, 6, "BOS07"),
+		game("2018-04-13", "BOS", "TBA", 5, 3, "STP01"),
+		game("2018-04-14", "BOS", "TBA", 4, 2, "STP01"),
+		game("2018-04-20", "BOS", "TBA", 1, 2, "STP01"),
+	})
+
+	assertEqual(t, len(series), 3)
+	assertEqual(t, series[0].ID, "BOS20180410NYA")
+	assertEqual(t, series[0].Record.Games, 3)
+	assertEqual(t, series[0].Result(), "won")
+	assertEqual(t, series[0].Swept(), false)
+	assertEqual(t, series[1].ID, "TBA20180413BOS")
+	assertEqual(t, series[1].Swept(), true)
+	assertEqual(t, series[2].Result(), "lost")
+
+	summary := summarizeSeries(series)
+
+	assertEqual(t, summary.Won, 2)
+	assertEqual(t, summary.Lost, 1)
+	assertEqual(t, summary.Sweeps, 1)
+	assertEqual(t, summary.Swept, 0)
+}
+
+func TestComputeTimeline(t *testing.T) {
+	timeline := computeTimeline("BOS", []GameScore{
+		game("2018-04-10", "NYA", "BOS", 1, 14, "BOS07"),
+		game("2018-04-11", "NYA", "BOS", 10, 7, "BOS07"),
+		game("2018-04-12", "NYA", "BOS", 2, 6, "BOS07"),
+		game("2018-04-13", "BOS", "TBA", 5, 3, "STP01"),
+		game("2018-04-14", "BOS", "TBA", 4, 4, "STP01"),
+		game("2018-04-15", "BOS", "TBA", 1, 2, "STP01"),
+	})
+
+	assertEqual(t, len(timeline.Games), 6)
+	assertEqual(t, timeline.Games[1].GamesAbove500, 0)
+	assertEqual(t, timeline.Games[1].RunDifferential, 10)
+	assertEqual(t, timeline.Games[3].Streak, "W2")
+	assertEqual(t, timeline.Games[4].Streak, "")
+	assertEqual(t, timeline.LongestWinStreak.Length, 2)
+	assertEqual(t, timeline.LongestWinStreak.From, "2018-04-12")
+	assertEqual(t, timeline.CurrentStreak.String(), "L1")
+	assertEqual(t, timeline.Record.Ties, 1)
+	assertEqual(t, timeline.RunDifferential, 15)
+}
+
+func TestComputeExpectedRecords(t *testing.T) {
+	// 2018 Red Sox
+	totals := TeamSeasonTotals{
+		Team:   "BOS",
+		Games:  162,
+		Wins:   108,
+		Losses: 54,
+		Batting: Batting{
+			Runs: 876, AtBats: 5623, Hits: 1509, Doubles: 355, Triples: 31, HomeRuns: 208,
+			Walks: 569, IntentionalWalks: 35, HitByPitch: 47, StolenBases: 125, CaughtStealing: 31,
+			GroundedIntoDoublePlay: 130,
+		},
+		OpponentBatting: Batting{Runs: 647},
+	}
+
+	expected := computeExpectedRecords(&totals)
+
+	assertEqual(t, expected.Pythagorean.Wins, 103)
+	assertEqual(t, expected.Pythagorean.Difference, 5)
+	assertEqual(t, expected.Pythagenpat.Wins+expected.Pythagenpat.Losses, 162)
+	assertEqual(t, math.Abs(expected.BaseRunsScored-876) < 20, true)
+}
+
+func TestParseLineScore(t *testing.T) {
+	innings := parseLineScore("00100(10)00x")
+
+	assertEqual(t, len(innings), 9)
+	assertEqual(t, innings[2], 1)
+	assertEqual(t, innings[5], 10)
+	assertEqual(t, innings[8], -1)
+	assertEqual(t, len(parseLineScore("0(1")), 0)
+}
+
+func TestNotableGames(t *testing.T) {
+	walkOff := Game{
+		VisitingTeamScore: 5,
+		HomeTeamScore:     6,
+		VisitingLineScore: "5000000000",
+		HomeLineScore:     "0000002301",
+		GameLengthInOuts:  60,
+		VisitingH:         7,
+		HomeH:             9,
+	}
+
+	assertEqual(t, isWalkOff(&walkOff), true)
+	assertEqual(t, isComeback(&walkOff), true)
+	assertEqual(t, isExtraInnings(&walkOff), true)
+	assertEqual(t, isNoHitter(&walkOff), false)
+	assertEqual(t, isShutout(&walkOff), false)
+
+	noHitter := Game{
+		VisitingTeamScore: 0,
+		HomeTeamScore:     12,
+		VisitingLineScore: "000000000",
+		HomeLineScore:     "40206000x",
+		GameLengthInOuts:  51,
+		VisitingH:         0,
+		HomeH:             14,
+	}
+
+	assertEqual(t, isNoHitter(&noHitter), true)
+	assertEqual(t, isShutout(&noHitter), true)
+	assertEqual(t, isBlowout(&noHitter), true)
+	assertEqual(t, isWalkOff(&noHitter), false)
+	assertEqual(t, isComeback(&noHitter), false)
+
+	// Home team won without a hit, the visitors pitched only 8 innings
+	noHitLoss := Game{
+		VisitingTeamScore: 0,
+		HomeTeamScore:     4,
+		VisitingLineScore: "000000000",
+		HomeLineScore:     "00000004x",
+		GameLengthInOuts:  51,
+		VisitingH:         4,
+		HomeH:             0,
+	}
+
+	assertEqual(t, isNoHitter(&noHitLoss), false)
+
+	noHitLoss.HomeLineScore = "000000004"
+	noHitLoss.GameLengthInOuts = 54
+
+	assertEqual(t, isNoHitter(&noHitLoss), true)
+
+	// Doubleheader games of 2020 were scheduled for seven innings
+	sevenInnings := Game{
+		Date:              time.Date(2020, time.August, 20, 0, 0, 0, 0, time.UTC),
+		NumberOfGame:      "2",
+		VisitingLineScore: "00100010",
+		HomeLineScore:     "00000201",
+		GameLengthInOuts:  47,
+	}
+
+	assertEqual(t, isExtraInnings(&sevenInnings), true)
+
+	sevenInnings.VisitingLineScore = ""
+
+	assertEqual(t, isExtraInnings(&sevenInnings), true)
+
+	sevenInnings.NumberOfGame = "0"
+
+	assertEqual(t, isExtraInnings(&sevenInnings), false)
+}
+
+func TestComputeTeamRateStats(t *testing.T) {
+	stats := TeamStats{
+		Games: 2,
+		Batting: Batting{
+			Runs: 9, AtBats: 70, Hits: 20, Doubles: 4, Triples: 1, HomeRuns: 3,
+			Walks: 8, HitByPitch: 1, SacrificeFlies: 1, Strikeouts: 16,
+		},
+		Pitching: Pitching{TeamEarnedRuns: 5},
+		Fielding: Fielding{Putouts: 54, Assists: 20, Errors: 2},
+	}
+
+	rates := computeTeamRateStats(&stats)
+
+	assertEqual(t, rates.Average, 0.286)
+	assertEqual(t, rates.OnBase, 0.363)
+	assertEqual(t, rates.Slugging, 0.5)
+	assertEqual(t, rates.EarnedRunAverage, 2.5)
+	assertEqual(t, rates.FieldingPct, 0.974)
+	assertEqual(t, rates.StrikeoutRate, 0.2)
+}
+
+func TestBuildTeamStatsQuery(t *testing.T) {
+	filter := TeamStatsFilter{Season: 2018, Team: "BOS"}
+
+	assertEqual(t, applyTeamStatsSplit(&filter, "vs=NYA"), true)
+	assertEqual(t, applyTeamStatsSplit(&filter, "road"), false)
+
+	query, args := buildTeamStatsQuery(&filter)
+
+	assertEqual(t, strings.HasSuffix(query, "where (season = $1) and (team = $2) and (opponent = $3) group by team order by team"), true)
+	assertEqual(t, strings.Contains(query, "greatest(home_2b, 0) as opponent_2b"), true)
+	assertEqual(t, len(args), 3)
+}
+
+func TestRankTeams(t *testing.T) {
+	teams := []TeamStats{
+		{Team: "BOS", Games: 162, Batting: Batting{GroundedIntoDoublePlay: 130}},
+		{Team: "NYA", Games: 162, Batting: Batting{GroundedIntoDoublePlay: 140}},
+		{Team: "TBA", Games: 162, Batting: Batting{GroundedIntoDoublePlay: 130}},
+		{Team: "TOR", Games: 81, Batting: Batting{GroundedIntoDoublePlay: 100}},
+	}
+
+	leaders, ok := rankTeams(teams, "grounded_into_double_play", false, false)
+
+	assertEqual(t, ok, true)
+	assertEqual(t, leaders[0].Team, "NYA")
+	assertEqual(t, leaders[1].Rank, 2)
+	assertEqual(t, leaders[2].Rank, 2)
+	assertEqual(t, leaders[2].Tied, true)
+	assertEqual(t, leaders[3].Rank, 4)
+
+	leaders, _ = rankTeams(teams, "grounded_into_double_play", true, false)
+
+	assertEqual(t, leaders[0].Team, "TOR")
+	assertEqual(t, leaders[0].Value, 1.235)
+
+	// Both average 0.673 per game once rounded
+	leaders, _ = rankTeams([]TeamStats{
+		{Team: "BOS", Games: 162, Batting: Batting{GroundedIntoDoublePlay: 109}},
+		{Team: "SEA", Games: 150, Batting: Batting{GroundedIntoDoublePlay: 101}},
+	}, "grounded_into_double_play", true, false)
+
+	assertEqual(t, leaders[0].Team, "SEA")
+	assertEqual(t, leaders[1].Rank, 2)
+	assertEqual(t, leaders[1].Tied, false)
+	assertEqual(t, leaders[1].Value, 0.673)
+
+	// Both ERAs are 3.74 once rounded, 3.7429 and 3.744 before
+	leaders, _ = rankTeams([]TeamStats{
+		{Team: "BOS", Games: 162, Pitching: Pitching{TeamEarnedRuns: 624}, Fielding: Fielding{Putouts: 4500}},
+		{Team: "SEA", Games: 162, Pitching: Pitching{TeamEarnedRuns: 585}, Fielding: Fielding{Putouts: 4220}},
+	}, "era", false, true)
+
+	assertEqual(t, leaders[0].Team, "SEA")
+	assertEqual(t, leaders[0].Value, 3.74)
+	assertEqual(t, leaders[1].Rank, 2)
+	assertEqual(t, leaders[1].Tied, false)
+	assertEqual(t, leaders[1].Value, 3.74)
+
+	_, ok = rankTeams(teams, "unknown", false, false)
+
+	assertEqual(t, ok, false)
+}
+
+func TestComputePlusStats(t *testing.T) {
+	league := sumTeamStats([]TeamStats{
+		{Games: 162, Batting: Batting{AtBats: 5500, Hits: 1400, Doubles: 280, HomeRuns: 180, Walks: 500}, Pitching: Pitching{TeamEarnedRuns: 700}, Fielding: Fielding{Putouts: 4300}},
+		{Games: 162, Batting: Batting{AtBats: 5500, Hits: 1400, Doubles: 280, HomeRuns: 180, Walks: 500}, Pitching: Pitching{TeamEarnedRuns: 700}, Fielding: Fielding{Putouts: 4300}},
+	})
+
+	assertEqual(t, league.Games, 324)
+	assertEqual(t, league.Batting.Hits, 2800)
+
+	average := computePlusStats(&league, &league, 100, "")
+
+	assertEqual(t, average.OPSPlus, 100)
+	assertEqual(t, average.ERAPlus, 100)
+
+	// A hitters' park lowers OPS+ and raises ERA+
+	hittersPark := computePlusStats(&league, &league, 110, "")
+
+	assertEqual(t, hittersPark.OPSPlus, 95)
+	assertEqual(t, hittersPark.ERAPlus, 105)
+
+	// Home games get the full park factor and away games none of it
+	home := computePlusStats(&league, &league, 110, "home")
+
+	assertEqual(t, home.OPSPlus, 91)
+	assertEqual(t, home.ERAPlus, 110)
+
+	away := computePlusStats(&league, &league, 110, "away")
+
+	assertEqual(t, away.OPSPlus, 100)
+	assertEqual(t, away.ERAPlus, 100)
+	assertEqual(t, away.ParkFactor, 100.0)
+}
+
+func TestFindLeagueChampions(t *testing.T) {
+	champions := findLeagueChampions([]TeamSeasonTotals{
+		{Team: "BOS", League: "AL", Wins: 108, Losses: 54},
+		{Team: "HOU", League: "AL", Wins: 103, Losses: 59},
+		{Team: "CHN", League: "NL", Wins: 95, Losses: 68},
+		{Team: "MIL", League: "NL", Wins: 96, Losses: 67},
+		{Team: "LAN", League: "NL", Wins: 96, Losses: 67},
+	})
+
+	assertEqual(t, len(champions), 3)
+	assertEqual(t, champions[0].Team, "BOS")
+	assertEqual(t, champions[1].Team, "MIL")
+	assertEqual(t, champions[2].Team, "LAN")
+}
+
+func TestComputeEloRatings(t *testing.T) {
+	ratings := computeEloRatings([]GameScore{
+		game("2017-09-30", "NYA", "BOS", 1, 4, "BOS07"),
+		game("2017-10-01", "NYA", "BOS", 5, 2, "BOS07"),
+		game("2018-04-10", "BOS", "NYA", 3, 3, "NYC21"),
+	}, DefaultEloConfig)
+
+	assertEqual(t, len(ratings), 6)
+	assertEqual(t, ratings[1].Team, "BOS")
+	assertEqual(t, roundTo(ratings[1].WinProbability, 3), 0.534)
+	assertEqual(t, ratings[1].RatingAfter+ratings[0].RatingAfter, 3000.0)
+	assertEqual(t, ratings[2].RatingBefore, ratings[0].RatingAfter)
+
+	// A third of the distance to 1500 is gone in the new season
+	assertEqual(t, roundTo(ratings[4].RatingBefore-1500, 6), roundTo((ratings[3].RatingAfter-1500)*2/3, 6))
+}
+
+func TestSimulateSeason(t *testing.T) {
+	teams := []ProjectionTeam{
+		{Team: "BOS", League: "AL", Division: "E", Wins: 100, Losses: 50, Rating: 1550},
+		{Team: "NYA", League: "AL", Division: "E", Wins: 90, Losses: 60, Rating: 1540},
+		{Team: "HOU", League: "AL", Division: "W", Wins: 80, Losses: 70, Rating: 1520},
+		{Team: "OAK", League: "AL", Division: "W", Wins: 79, Losses: 71, Rating: 1500},
+	}
+
+	date, _ := time.Parse("2006-01-02", "2018-09-20")
+
+	var games []RemainingGame
+
+	for i := 0; i < 6; i++ {
+		games = append(games, RemainingGame{VisitingTeam: "OAK", HomeTeam: "HOU", Date: date})
+	}
+
+	format := PlayoffFormat{Divisions: true, WildCards: 1}
+
+	// Games of teams that are not in the standings cannot be simulated
+	games = append(games, RemainingGame{VisitingTeam: "SEA", HomeTeam: "HOU", Date: date})
+
+	projections, simulated := simulateSeason(teams, games, format, 24, 2000, rand.New(rand.NewSource(1)))
+	again, _ := simulateSeason(teams, games, format, 24, 2000, rand.New(rand.NewSource(1)))
+
+	assertEqual(t, simulated, 6)
+	assertEqual(t, len(projections), 4)
+	assertEqual(t, projections[0].Team, "BOS")
+	assertEqual(t, projections[0].DivisionOdds, 1.0)
+	assertEqual(t, projections[1].PlayoffOdds, 1.0)
+	assertEqual(t, projections[2].Team, "HOU")
+	assertEqual(t, projections[2].DivisionOdds > 0.5, true)
+	assertEqual(t, math.Abs(projections[2].DivisionOdds+projections[3].DivisionOdds-1) < 0.002, true)
+	assertEqual(t, math.Abs(projections[2].ProjectedWins+projections[3].ProjectedWins-165) < 0.2, true)
+	assertEqual(t, projections[2].DivisionOdds, again[2].DivisionOdds)
+}
+
+func TestBestRecord(t *testing.T) {
+	// A rained out game that was never made up leaves the second team a game short
+	wins := []int{90, 89, 70}
+	losses := []int{72, 71, 92}
+
+	assertEqual(t, bestRecord([]int{0, 1, 2}, wins, losses, rand.New(rand.NewSource(1))), 1)
+}
+
+func TestAddScheduleTeams(t *testing.T) {
+	totals := addScheduleTeams([]TeamSeasonTotals{{Team: "NYA", League: "AL", Games: 1, Wins: 1}}, []TeamSeasonTotals{
+		{Team: "BOS", League: "AL"},
+		{Team: "NYA", League: "AL"},
+		{Team: "ATL", League: "NL"},
+	})
+
+	assertEqual(t, len(totals), 3)
+	assertEqual(t, totals[0].Team, "BOS")
+	assertEqual(t, totals[0].Games, 0)
+	assertEqual(t, totals[1].Wins, 1)
+	assertEqual(t, totals[2].Team, "ATL")
+	assertEqual(t, getRunDifferentialRating(&totals[0]), 1500.0)
+}
+
+func TestInningsStats(t *testing.T) {
+	views := getTeamInnings(&GameLineScore{
+		VisitingTeam:      "NYA",
+		HomeTeam:          "BOS",
+		VisitingTeamScore: 5,
+		HomeTeamScore:     6,
+		VisitingLineScore: "0104000000",
+		HomeLineScore:     "0000002301",
+	})
+
+	assertEqual(t, len(views), 2)
+
+	var visiting InningsStats
+	var home InningsStats
+
+	visiting.add(&views[0])
+	home.add(&views[1])
+
+	assertEqual(t, visiting.ScoredFirst, 1)
+	assertEqual(t, visiting.ScoredFirstWins, 0)
+	assertEqual(t, visiting.BigInnings, 1)
+	assertEqual(t, home.RunsByInning[7], 3)
+	assertEqual(t, home.LateInningRuns, 5)
+	assertEqual(t, home.ExtraInningRuns, 1)
+	assertEqual(t, home.LateComebackWins, 1)
+
+	mismatched := getTeamInnings(&GameLineScore{VisitingTeamScore: 3, VisitingLineScore: "000", HomeLineScore: "00x"})
+
+	assertEqual(t, len(mismatched), 0)
+}
+
+func TestComputeLineupUsage(t *testing.T) {
+	lineup := func(thirdBatter string, position string) []Player {
+		return []Player{
+			{ID: "bettm001", PositionSymbol: "RF"},
+			{ID: "benia002", PositionSymbol: "LF"},
+			{ID: thirdBatter, PositionSymbol: position},
+		}
+	}
+
+	var games []LineupGame
+
+	for i := 0; i < 12; i++ {
+		games = append(games, LineupGame{Lineup: lineup("pears001", "1B"), OpposingStarterThrows: "L"})
+		games = append(games, LineupGame{Lineup: lineup("morem001", "1B"), OpposingStarterThrows: "R"})
+		games = append(games, LineupGame{Lineup: lineup("morem001", "1B"), OpposingStarterThrows: "R"})
+	}
+
+	games = append(games, LineupGame{Lineup: lineup("morem001", "DH"), OpposingStarterThrows: "R"})
+
+	usage := computeLineupUsage(games)
+
+	assertEqual(t, usage.Games, 37)
+	assertEqual(t, usage.DistinctLineups, 3)
+	assertEqual(t, usage.DistinctBattingOrders, 2)
+	assertEqual(t, usage.MostCommonLineupGames, 24)
+	assertEqual(t, usage.Players[0].ID, "bettm001")
+	assertEqual(t, usage.Players[0].Platoon, "")
+	assertEqual(t, usage.Players[2].ID, "morem001")
+	assertEqual(t, usage.Players[2].Positions["DH"], 1)
+	assertEqual(t, usage.Players[2].BattingSlots[2], 25)
+	assertEqual(t, usage.Players[3].Platoon, "L")
+}
+
+func TestGetDaysRest(t *testing.T) {
+	day := func(value string) time.Time {
+		date, _ := time.Parse("2006-01-02", value)
+		return date
+	}
+
+	assertEqual(t, getDaysRest(day("2018-04-01"), day("2018-04-06")), 4)
+	assertEqual(t, getDaysRest(day("2018-04-01"), day("2018-04-02")), 0)
+	assertEqual(t, getDaysRest(day("2018-04-01"), day("2018-04-01")), 0)
+}
+
+func TestComputeRotation(t *testing.T) {
+	date, _ := time.Parse("2006-01-02", "2018-04-01")
+
+	pitchers := []string{"salec001", "pricd001", "porcr001", "rodre003", "wrigs001"}
+
+	var starts []TeamStart
+
+	// The fourth starter was traded for after starting for another team
+	previousStarts := map[string]pq.NullTime{
+		"rodre003": {Time: date.AddDate(0, 0, -2), Valid: true},
+	}
+
+	for i := 0; i < 30; i++ {
+		id := pitchers[i%5]
+
+		// The fifth starter is skipped once after an off-day
+		if i >= 14 {
+			id = pitchers[(i+1)%5]
+		}
+
+		start := TeamStart{
+			Date:          date.AddDate(0, 0, i+i/10),
+			NumberOfGame:  "0",
+			GameNumber:    i + 1,
+			Pitcher:       Person{ID: id},
+			PreviousStart: previousStarts[id],
+		}
+
+		previousStarts[id] = pq.NullTime{Time: start.Date, Valid: true}
+		starts = append(starts, start)
+	}
+
+	rotation := computeRotation(starts)
+
+	assertEqual(t, len(rotation.Starts), 30)
+	assertEqual(t, len(rotation.Rotation), 5)
+
+	for i, pitcher := range rotation.Rotation {
+		assertEqual(t, pitcher.ID, pitchers[i])
+	}
+
+	assertEqual(t, rotation.Starts[0].DaysRest == nil, true)
+	assertEqual(t, *rotation.Starts[3].DaysRest, 4)
+	assertEqual(t, *rotation.Starts[5].DaysRest, 4)
+	assertEqual(t, *rotation.Starts[14].DaysRest, 3)
+	assertEqual(t, rotation.Starts[14].RotationSlot, 1)
+	assertEqual(t, rotation.Pitchers[0].Pitcher.ID, "salec001")
+	assertEqual(t, rotation.Pitchers[0].Starts, 7)
+	assertEqual(t, rotation.Pitchers[0].StartsOnShortRest, 1)
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+)
+
+type TeamStreak struct {
+	// "W" for wins, "L" for losses, empty when there is no streak
+	Type   string `json:"type"`
+	Length int    `json:"length"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+}
+
+func (streak *TeamStreak) String() string {
+	if streak.Length == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s%d", streak.Type, streak.Length)
+}
+
+type TimelineEntry struct {
+	GameNumber   int    `json:"game_number"`
+	Date         string `json:"date"`
+	NumberOfGame string `json:"number_of_game"`
+	Opponent     string `json:"opponent"`
+	Home         bool   `json:"home"`
+	RunsScored   int    `json:"runs_scored"`
+	RunsAllowed  int    `json:"runs_allowed"`
+	// "W", "L" or "T"
+	Result          string `json:"result"`
+	Wins            int    `json:"wins"`
+	Losses          int    `json:"losses"`
+	Ties            int    `json:"ties"`
+	GamesAbove500   int    `json:"games_above_500"`
+	RunDifferential int    `json:"run_differential"`
+	Streak          string `json:"streak"`
+}
+
+type TeamTimeline struct {
+	Record            MatchupRecord   `json:"record"`
+	RunDifferential   int             `json:"run_differential"`
+	LongestWinStreak  TeamStreak      `json:"longest_win_streak"`
+	LongestLossStreak TeamStreak      `json:"longest_loss_streak"`
+	CurrentStreak     TeamStreak      `json:"current_streak"`
+	Games             []TimelineEntry `json:"games"`
+}
+
+// Games must be in chronological order. Ties end a streak.
+func computeTimeline(team string, games []GameScore) TeamTimeline {
+	timeline := TeamTimeline{
+		Games: []TimelineEntry{},
+	}
+
+	var current TeamStreak
+
+	for _, game := range games {
+		scored, allowed, winner := game.resultFor(team)
+		date := game.Date.Format("2006-01-02")
+
+		timeline.Record.add(scored, allowed, winner, team)
+
+		result := "T"
+
+		if winner == team {
+			result = "W"
+		} else if winner != "" {
+			result = "L"
+		}
+
+		if result == "T" {
+			current = TeamStreak{}
+		} else if result == current.Type {
+			current.Length++
+			current.To = date
+		} else {
+			current = TeamStreak{Type: result, Length: 1, From: date, To: date}
+		}
+
+		if current.Type == "W" && current.Length > timeline.LongestWinStreak.Length {
+			timeline.LongestWinStreak = current
+		} else if current.Type == "L" && current.Length > timeline.LongestLossStreak.Length {
+			timeline.LongestLossStreak = current
+		}
+
+		record := timeline.Record
+
+		timeline.Games = append(timeline.Games, TimelineEntry{
+			GameNumber:      game.gameNumberOf(team),
+			Date:            date,
+			NumberOfGame:    game.NumberOfGame,
+			Opponent:        game.opponentOf(team),
+			Home:            game.HomeTeam == team,
+			RunsScored:      scored,
+			RunsAllowed:     allowed,
+			Result:          result,
+			Wins:            record.Wins,
+			Losses:          record.Losses,
+			Ties:            record.Ties,
+			GamesAbove500:   record.Wins - record.Losses,
+			RunDifferential: record.RunsScored - record.RunsAllowed,
+			Streak:          current.String(),
+		})
+	}
+
+	timeline.RunDifferential = timeline.Record.RunsScored - timeline.Record.RunsAllowed
+	timeline.CurrentStreak = current
+
+	return timeline
+}
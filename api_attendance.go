@@ -0,0 +1,180 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var daysOfWeekOrder = map[string]int{
+	"Sun": 0, "Mon": 1, "Tue": 2, "Wed": 3, "Thu": 4, "Fri": 5, "Sat": 6,
+}
+
+var dayNightNames = map[string]string{
+	"D": "day",
+	"N": "night",
+}
+
+type AttendanceGroup struct {
+	Key                       string   `json:"key"`
+	Name                      string   `json:"name"`
+	Games                     int      `json:"games"`
+	TotalAttendance           int64    `json:"total_attendance"`
+	AverageAttendance         float64  `json:"average_attendance"`
+	PreviousAverageAttendance *float64 `json:"previous_average_attendance"`
+	ChangePercent             *float64 `json:"change_percent"`
+}
+
+type AttendanceResponse struct {
+	GroupBy string            `json:"group_by"`
+	Season  int               `json:"season"`
+	Team    string            `json:"team"`
+	Park    string            `json:"venue_id"`
+	Groups  []AttendanceGroup `json:"groups"`
+}
+
+func getAttendanceGroupName(grouping string, key string, date time.Time) string {
+	switch grouping {
+	case "team":
+		return getTeamNameData(key, date).FullName
+	case "park":
+		if park, ok := PARKS[key]; ok {
+			return park.Name
+		}
+	case "day_night":
+		return dayNightNames[key]
+	}
+
+	return key
+}
+
+// Averages of the previous season, keyed like the groups, or nil when year-over-year change doesn't apply
+func loadPreviousAttendance(grouping string, totals []AttendanceTotals, season int, team string, park string) (map[string]float64, error) {
+	previous := make(map[string]float64)
+
+	if grouping == "season" && season == 0 {
+		for _, total := range totals {
+			previous[strconv.Itoa(parseInt(total.Key)+1)] = total.AverageAttendance
+		}
+
+		return previous, nil
+	}
+
+	if grouping == "date" || season == 0 {
+		return nil, nil
+	}
+
+	previousTotals, err := loadAttendance(grouping, season-1, team, park)
+
+	for _, total := range previousTotals {
+		key := total.Key
+
+		// Seasons are keyed by the year, so the previous one is matched to the next
+		if grouping == "season" {
+			key = strconv.Itoa(parseInt(key) + 1)
+		}
+
+		previous[key] = total.AverageAttendance
+	}
+
+	return previous, err
+}
+
+func getAttendance(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	grouping := query.Get("group_by")
+
+	if grouping == "" {
+		grouping = "season"
+	}
+
+	season := 0
+
+	if value := query.Get("season"); value != "" {
+		season = parseInt(value)
+	}
+
+	team := query.Get("team")
+	park := query.Get("park")
+
+	if season < 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "season must be a year"}},
+		})
+		return
+	}
+
+	if _, ok := attendanceGroupings[grouping]; !ok {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "group_by must be one of team, park, season, date, day_of_week, day_night"}},
+		})
+		return
+	}
+
+	totals, err := loadAttendance(grouping, season, team, park)
+
+	var previous map[string]float64
+
+	if err == nil {
+		previous, err = loadPreviousAttendance(grouping, totals, season, team, park)
+	}
+
+	if err != nil {
+		w.WriteHeader(500)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Could not load attendance"}},
+		})
+		return
+	}
+
+	if grouping == "day_of_week" {
+		sort.SliceStable(totals, func(i, j int) bool {
+			return daysOfWeekOrder[totals[i].Key] < daysOfWeekOrder[totals[j].Key]
+		})
+	}
+
+	namesDate := time.Now()
+
+	if season != 0 {
+		namesDate = time.Date(season, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+
+	response := AttendanceResponse{
+		GroupBy: grouping,
+		Season:  season,
+		Team:    team,
+		Park:    park,
+		Groups:  []AttendanceGroup{},
+	}
+
+	for _, total := range totals {
+		group := AttendanceGroup{
+			Key:               total.Key,
+			Name:              getAttendanceGroupName(grouping, total.Key, namesDate),
+			Games:             total.Games,
+			TotalAttendance:   total.TotalAttendance,
+			AverageAttendance: math.Round(total.AverageAttendance),
+		}
+
+		if previousAverage, ok := previous[total.Key]; ok && previousAverage > 0 {
+			change := math.Round((total.AverageAttendance/previousAverage-1)*1000) / 10
+			previousAverage = math.Round(previousAverage)
+
+			group.PreviousAverageAttendance = &previousAverage
+			group.ChangePercent = &change
+		}
+
+		response.Groups = append(response.Groups, group)
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
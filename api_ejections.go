@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+type Ejection struct {
+	Person Person `json:"person"`
+	Team   string `json:"team"`
+	Job    string `json:"job"`
+	Umpire Person `json:"umpire"`
+	Inning int    `json:"inning"`
+	Reason string `json:"reason"`
+}
+
+type GameEjections struct {
+	Date         string     `json:"date"`
+	NumberOfGame string     `json:"number_of_game"`
+	Ejections    []Ejection `json:"ejections"`
+}
+
+func (game *GameEjections) personIDs() []*string {
+	var ids []*string
+
+	for i := range game.Ejections {
+		ids = append(ids, &game.Ejections[i].Person.ID, &game.Ejections[i].Umpire.ID)
+	}
+
+	return ids
+}
+
+type EjectionsResponse struct {
+	Games []GameEjections `json:"games"`
+}
+
+func getGameSummaryEjections(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	date := params["date"]
+	teams := strings.Split(params["teams"], "@")
+
+	idScheme, ok := getIDScheme(req)
+
+	if !ok {
+		writeIDSchemeError(w)
+		return
+	}
+
+	// TODO: Factor out validation for game summary endpoints
+	if len(teams) != 2 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide two teams"}},
+		})
+		return
+	}
+
+	visitingTeam := teams[0]
+	homeTeam := teams[1]
+
+	games, err := loadGames(date, visitingTeam, homeTeam)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+	if len(games) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	ejections, err := loadGameEjections(date, homeTeam)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	var data []GameEjections
+
+	for _, game := range games {
+		gameEjections := GameEjections{
+			Date:         game.Date.Format("2006-01-02"),
+			NumberOfGame: game.NumberOfGame,
+			Ejections:    []Ejection{},
+		}
+
+		for _, ejection := range ejections {
+			if ejection.NumberOfGame != game.NumberOfGame {
+				continue
+			}
+
+			job, ok := EjectionJobNamesMap[ejection.Job]
+
+			if !ok {
+				job = ejection.Job
+			}
+
+			gameEjections.Ejections = append(gameEjections.Ejections, Ejection{
+				Person: Person{
+					ID:   ejection.PersonID,
+					Name: ejection.PersonName,
+				},
+				Team: ejection.Team,
+				Job:  job,
+				Umpire: Person{
+					ID:   ejection.UmpireID,
+					Name: ejection.UmpireName,
+				},
+				Inning: ejection.Inning,
+				Reason: ejection.Reason,
+			})
+		}
+
+		data = append(data, gameEjections)
+	}
+
+	var ids []*string
+
+	for i := range data {
+		ids = append(ids, data[i].personIDs()...)
+	}
+
+	if err := rewritePersonIDs(idScheme, ids); err != nil {
+		writeIDRewriteError(w)
+		return
+	}
+
+	json.NewEncoder(w).Encode(EjectionsResponse{
+		Games: data,
+	})
+}
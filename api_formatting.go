@@ -1,6 +1,11 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
 
 type TeamNameData struct {
 	FullName string
@@ -9,7 +14,29 @@ type TeamNameData struct {
 	Location string
 }
 
-func getTeamNameData(teamSymbol string) *TeamNameData {
+func findFranchiseEra(teamSymbol string, date time.Time) *RawFranchiseEra {
+	for _, era := range FRANCHISE_ERAS[teamSymbol] {
+		if era.Contains(date) {
+			return era
+		}
+	}
+
+	return nil
+}
+
+// Names are resolved from the franchise era the date falls in, falling back to the current team names
+func getTeamNameData(teamSymbol string, date time.Time) *TeamNameData {
+	era := findFranchiseEra(teamSymbol, date)
+
+	if era != nil {
+		return &TeamNameData{
+			Symbol:   teamSymbol,
+			Name:     era.Name,
+			Location: era.Location,
+			FullName: fmt.Sprintf("%s %s", era.Location, era.Name),
+		}
+	}
+
 	teamData, ok := TEAMS[teamSymbol]
 
 	if ok {
@@ -23,3 +50,20 @@ func getTeamNameData(teamSymbol string) *TeamNameData {
 
 	return &TeamNameData{}
 }
+
+func getParkURL(parkID string) string {
+	return fmt.Sprintf("/api/v1/parks/%s", parkID)
+}
+
+func formatNullDate(date pq.NullTime) string {
+	if !date.Valid {
+		return ""
+	}
+
+	return date.Time.Format("2006-01-02")
+}
+
+// Last day of the season, used to name teams as they were at the end of it
+func getSeasonEnd(season int) time.Time {
+	return time.Date(season, time.December, 31, 0, 0, 0, 0, time.UTC)
+}
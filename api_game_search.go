@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
+
+const defaultGameSearchLimit = 25
+const maxGameSearchLimit = 100
+
+type GameSearchResponse struct {
+	Games []GameSummary `json:"games"`
+	// Pass as ?cursor= to get the next page, empty on the last page
+	NextCursor string `json:"next_cursor"`
+}
+
+// Translates a person ID given in the requested scheme to a Retrosheet ID
+func getRetrosheetID(scheme string, id string) (string, error) {
+	if scheme == RetrosheetIDScheme || id == "" {
+		return id, nil
+	}
+
+	return loadPersonIDBySourceID(scheme, id)
+}
+
+// Reads the search parameters, collecting every problem with them
+func readGameSearch(req *http.Request, idScheme string) (*GameSearch, []Error) {
+	query := req.URL.Query()
+
+	var errors []Error
+
+	search := GameSearch{
+		Team:     query.Get("team"),
+		Park:     query.Get("park"),
+		DayNight: strings.ToUpper(query.Get("day_night")),
+		MinRuns:  -1,
+		Sort:     "date",
+		Limit:    defaultGameSearchLimit,
+	}
+
+	var ok bool
+
+	if search.From, ok = getDateParam(req, "from", ""); !ok {
+		errors = append(errors, Error{Message: "from must be in YYYY-MM-DD format"})
+	}
+
+	if search.To, ok = getDateParam(req, "to", ""); !ok {
+		errors = append(errors, Error{Message: "to must be in YYYY-MM-DD format"})
+	}
+
+	if search.DayNight != "" && search.DayNight != "D" && search.DayNight != "N" {
+		errors = append(errors, Error{Message: "day_night must be D or N"})
+	}
+
+	if value := query.Get("min_runs"); value != "" {
+		if search.MinRuns = parseInt(value); search.MinRuns < 0 {
+			errors = append(errors, Error{Message: "min_runs must be a non-negative number"})
+		}
+	}
+
+	if value := query.Get("limit"); value != "" {
+		if search.Limit = parseInt(value); search.Limit < 1 || search.Limit > maxGameSearchLimit {
+			errors = append(errors, Error{Message: "limit must be between 1 and 100"})
+		}
+	}
+
+	if value := query.Get("sort"); value != "" {
+		search.Descending = strings.HasPrefix(value, "-")
+		search.Sort = strings.TrimPrefix(value, "-")
+
+		if _, ok := GameSortFields[search.Sort]; !ok {
+			errors = append(errors, Error{Message: "sort must be one of date, attendance, time_of_game, runs, length_in_outs, optionally prefixed with -"})
+		}
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		if search.Cursor, ok = decodeGameCursor(value); !ok {
+			errors = append(errors, Error{Message: "Invalid cursor"})
+		}
+	}
+
+	var err error
+
+	if search.Pitcher, err = getRetrosheetID(idScheme, query.Get("pitcher")); err != nil {
+		errors = append(errors, Error{Message: "Invalid pitcher"})
+	}
+
+	if search.Umpire, err = getRetrosheetID(idScheme, query.Get("umpire")); err != nil {
+		errors = append(errors, Error{Message: "Invalid umpire"})
+	}
+
+	return &search, errors
+}
+
+func getGames(w http.ResponseWriter, req *http.Request) {
+	idScheme, ok := getIDScheme(req)
+
+	if !ok {
+		writeIDSchemeError(w)
+		return
+	}
+
+	search, errors := readGameSearch(req, idScheme)
+
+	if len(errors) > 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: errors,
+		})
+		return
+	}
+
+	response := GameSearchResponse{
+		Games: []GameSummary{},
+	}
+
+	// People without an ID in the requested scheme have no games
+	if (req.URL.Query().Get("pitcher") != "" && search.Pitcher == "") ||
+		(req.URL.Query().Get("umpire") != "" && search.Umpire == "") {
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	games, cursor, err := searchGames(search)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if response.Games, err = getGameSummaries(idScheme, games); err != nil {
+		writeIDRewriteError(w)
+		return
+	}
+
+	if cursor != nil {
+		response.NextCursor = encodeGameCursor(cursor)
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Person IDs in responses are Retrosheet IDs unless another scheme is requested
+const RetrosheetIDScheme = "retro"
+
+func getIDScheme(req *http.Request) (string, bool) {
+	scheme := req.URL.Query().Get("id_scheme")
+
+	if scheme == "" || scheme == RetrosheetIDScheme {
+		return RetrosheetIDScheme, true
+	}
+
+	_, ok := IDSourceColumns[scheme]
+
+	return scheme, ok
+}
+
+// Replaces Retrosheet IDs in place, IDs without a mapping in the scheme become empty
+func rewritePersonIDs(scheme string, ids []*string) error {
+	if scheme == RetrosheetIDScheme {
+		return nil
+	}
+
+	var personIDs []string
+
+	for _, id := range ids {
+		if *id != "" {
+			personIDs = append(personIDs, *id)
+		}
+	}
+
+	sourceIDs, err := loadSourceIDs(scheme, personIDs)
+
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		*id = sourceIDs[*id]
+	}
+
+	return nil
+}
+
+func writeIDSchemeError(w http.ResponseWriter) {
+	w.WriteHeader(400)
+
+	json.NewEncoder(w).Encode(ResponseErrors{
+		Errors: []Error{{Message: "Unknown id_scheme"}},
+	})
+}
+
+func writeIDRewriteError(w http.ResponseWriter) {
+	w.WriteHeader(500)
+
+	json.NewEncoder(w).Encode(ResponseErrors{
+		Errors: []Error{{Message: "Could not map person ids"}},
+	})
+}
+
+func getPersonLookup(w http.ResponseWriter, req *http.Request) {
+	source := req.URL.Query().Get("source")
+	sourceID := req.URL.Query().Get("id")
+
+	if _, ok := IDSourceColumns[source]; !ok || sourceID == "" {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a known source and an id"}},
+		})
+		return
+	}
+
+	personID, err := loadPersonIDBySourceID(source, sourceID)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if personID == "" {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "There is no person with that id"}},
+		})
+		return
+	}
+
+	writePerson(w, personID)
+}
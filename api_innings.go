@@ -0,0 +1,165 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// Line scores are read into memory, so requests are limited to this many seasons
+const maxInningsSeasons = 10
+
+type InningsGroup struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	InningsStats
+	// Rates are per team per game
+	RunsPerInning          [9]float64 `json:"runs_per_game_by_inning"`
+	FirstInningScoringRate float64    `json:"first_inning_scoring_pct"`
+	ScoresFirstRate        float64    `json:"scores_first_pct"`
+	ScoresFirstWinRate     float64    `json:"win_pct_when_scoring_first"`
+	LateInningRunsPerGame  float64    `json:"runs_in_innings_7_to_9_per_game"`
+	BigInningsPerGame      float64    `json:"big_innings_per_game"`
+}
+
+type InningsResponse struct {
+	GroupBy string         `json:"group_by"`
+	Season  int            `json:"season"`
+	From    int            `json:"from"`
+	To      int            `json:"to"`
+	Team    string         `json:"team"`
+	Park    string         `json:"venue_id"`
+	Groups  []InningsGroup `json:"groups"`
+}
+
+func getInningsGroup(key string, stats *InningsStats) InningsGroup {
+	group := InningsGroup{
+		Key:                    key,
+		Name:                   key,
+		InningsStats:           *stats,
+		FirstInningScoringRate: roundTo(ratio(stats.FirstInningScored, stats.Games), 3),
+		ScoresFirstRate:        roundTo(ratio(stats.ScoredFirst, stats.Games), 3),
+		ScoresFirstWinRate:     roundTo(ratio(stats.ScoredFirstWins, stats.ScoredFirst), 3),
+		LateInningRunsPerGame:  roundTo(ratio(stats.LateInningRuns, stats.Games), 2),
+		BigInningsPerGame:      roundTo(ratio(stats.BigInnings, stats.Games), 2),
+	}
+
+	for i, runs := range stats.RunsByInning {
+		group.RunsPerInning[i] = roundTo(ratio(runs, stats.Games), 2)
+	}
+
+	return group
+}
+
+func getInnings(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	groupBy := query.Get("group_by")
+	team := query.Get("team")
+	park := query.Get("park")
+	season := 0
+	from := parseInt(query.Get("from"))
+	to := parseInt(query.Get("to"))
+
+	if groupBy == "" {
+		groupBy = "season"
+	}
+
+	if value := query.Get("season"); value != "" {
+		season = parseInt(value)
+		from = season
+		to = season
+	}
+
+	if groupBy != "season" && groupBy != "team" && groupBy != "park" {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "group_by must be one of season, team, park"}},
+		})
+		return
+	}
+
+	if from <= 0 || to < from || to-from >= maxInningsSeasons {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a season, or from and to seasons at most 10 seasons apart"}},
+		})
+		return
+	}
+
+	games, err := loadLineScores(from, to, team, park)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	stats := make(map[string]*InningsStats)
+
+	for i := range games {
+		for _, view := range getTeamInnings(&games[i]) {
+			// With a team filter only its own innings count
+			if team != "" && view.Team != team {
+				continue
+			}
+
+			key := games[i].ParkID
+
+			if groupBy == "season" {
+				key = strconv.Itoa(games[i].Date.Year())
+			} else if groupBy == "team" {
+				key = view.Team
+			}
+
+			if _, ok := stats[key]; !ok {
+				stats[key] = &InningsStats{}
+			}
+
+			stats[key].add(&view)
+		}
+	}
+
+	if len(stats) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	response := InningsResponse{
+		GroupBy: groupBy,
+		Season:  season,
+		From:    from,
+		To:      to,
+		Team:    team,
+		Park:    park,
+		Groups:  []InningsGroup{},
+	}
+
+	for key, groupStats := range stats {
+		group := getInningsGroup(key, groupStats)
+
+		if groupBy == "team" {
+			group.Name = getTeamNameData(key, games[len(games)-1].Date).FullName
+		} else if park, ok := PARKS[key]; ok && groupBy == "park" {
+			group.Name = park.Name
+		}
+
+		response.Groups = append(response.Groups, group)
+	}
+
+	sort.Slice(response.Groups, func(i, j int) bool {
+		return response.Groups[i].Key < response.Groups[j].Key
+	})
+
+	json.NewEncoder(w).Encode(response)
+}
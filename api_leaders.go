@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type TeamLeaderData struct {
+	TeamLeader
+	FullTeamName string `json:"full_team_name"`
+}
+
+type TeamLeadersResponse struct {
+	Stat    string           `json:"stat"`
+	Season  int              `json:"season"`
+	League  string           `json:"league"`
+	PerGame bool             `json:"per_game"`
+	Order   string           `json:"order"`
+	Leaders []TeamLeaderData `json:"leaders"`
+}
+
+func getTeamLeaders(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	stat := query.Get("stat")
+	season := parseInt(query.Get("season"))
+	league := query.Get("league")
+	perGame := query.Get("per_game") == "true"
+	order := query.Get("order")
+
+	if order == "" {
+		order = "desc"
+
+		if LowerIsBetterStats[stat] {
+			order = "asc"
+		}
+	}
+
+	if stat == "" || season <= 0 || (order != "asc" && order != "desc") {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a stat and a season, order must be asc or desc"}},
+		})
+		return
+	}
+
+	teams, err := loadTeamStats(&TeamStatsFilter{Season: season, League: league})
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(teams) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	leaders, ok := rankTeams(teams, stat, perGame, order == "asc")
+
+	if !ok {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Unknown stat"}},
+		})
+		return
+	}
+
+	response := TeamLeadersResponse{
+		Stat:    stat,
+		Season:  season,
+		League:  league,
+		PerGame: perGame,
+		Order:   order,
+		Leaders: []TeamLeaderData{},
+	}
+
+	for _, leader := range leaders {
+		response.Leaders = append(response.Leaders, TeamLeaderData{
+			TeamLeader:   leader,
+			FullTeamName: getTeamNameData(leader.Team, getSeasonEnd(season)).FullName,
+		})
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// Parks without enough games for a factor are treated as neutral
+const neutralParkFactor = 100.0
+
+type LeagueTeamPlusStats struct {
+	Team MatchupTeam `json:"team"`
+	PlusStats
+}
+
+type LeagueSeasonResponse struct {
+	League      string                `json:"league"`
+	Season      int                   `json:"season"`
+	Environment LeagueEnvironment     `json:"environment"`
+	Teams       []LeagueTeamPlusStats `json:"teams"`
+}
+
+// Three year run factor of the park where the team played most of its home games
+func loadTeamRunsParkFactor(team string, season int) (float64, error) {
+	parkID, err := loadMainHomePark(team, season)
+
+	if err != nil || parkID == "" {
+		return neutralParkFactor, err
+	}
+
+	splits, err := loadParkFactorSplits(parkID)
+
+	if err != nil {
+		return neutralParkFactor, err
+	}
+
+	for _, factors := range computeParkFactors(splits, 3) {
+		if factors.Season == season && factors.Factors.Runs != nil {
+			return *factors.Factors.Runs, nil
+		}
+	}
+
+	return neutralParkFactor, nil
+}
+
+// Side is home or away when the stats only cover those games
+func loadTeamPlusStats(stats *TeamStats, league *TeamStats, season int, side string) (PlusStats, error) {
+	parkFactor, err := loadTeamRunsParkFactor(stats.Team, season)
+
+	if err != nil {
+		return PlusStats{}, err
+	}
+
+	return computePlusStats(stats, league, parkFactor, side), nil
+}
+
+// Team stats are normalised against the league as a whole, not against the split
+func loadTeamPlusStatsInLeague(stats *TeamStats, season int, side string) (PlusStats, error) {
+	teams, err := loadTeamStats(&TeamStatsFilter{Season: season, League: stats.League})
+
+	if err != nil {
+		return PlusStats{}, err
+	}
+
+	league := sumTeamStats(teams)
+
+	return loadTeamPlusStats(stats, &league, season, side)
+}
+
+func getLeagueSeason(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	league := params["league"]
+	season := parseInt(params["year"])
+
+	if season <= 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Season must be a year"}},
+		})
+		return
+	}
+
+	teams, err := loadTeamStats(&TeamStatsFilter{Season: season, League: league})
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(teams) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	total := sumTeamStats(teams)
+
+	response := LeagueSeasonResponse{
+		League:      league,
+		Season:      season,
+		Environment: computeLeagueEnvironment(teams),
+		Teams:       []LeagueTeamPlusStats{},
+	}
+
+	for i := range teams {
+		plus, err := loadTeamPlusStats(&teams[i], &total, season, "")
+
+		if err != nil {
+			w.WriteHeader(500)
+
+			json.NewEncoder(w).Encode(ResponseErrors{
+				Errors: []Error{{Message: "Could not load park factors"}},
+			})
+			return
+		}
+
+		response.Teams = append(response.Teams, LeagueTeamPlusStats{
+			Team: MatchupTeam{
+				Symbol:       teams[i].Team,
+				FullTeamName: getTeamNameData(teams[i].Team, getSeasonEnd(season)).FullName,
+			},
+			PlusStats: plus,
+		})
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
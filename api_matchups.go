@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type MatchupTeam struct {
+	Symbol       string `json:"symbol"`
+	FullTeamName string `json:"full_team_name"`
+}
+
+type MatchupGameSummary struct {
+	Date              string `json:"date"`
+	NumberOfGame      string `json:"number_of_game"`
+	VisitingTeam      string `json:"visiting_team"`
+	HomeTeam          string `json:"home_team"`
+	VisitingTeamScore int    `json:"visiting_team_runs"`
+	HomeTeamScore     int    `json:"home_team_runs"`
+	ParkID            string `json:"venue_id"`
+	Winner            string `json:"winner"`
+}
+
+type MatchupResponse struct {
+	TeamA MatchupTeam `json:"team_a"`
+	TeamB MatchupTeam `json:"team_b"`
+	From  string      `json:"from"`
+	To    string      `json:"to"`
+	Matchup
+	Games []MatchupGameSummary `json:"games"`
+}
+
+// Reads an optional YYYY-MM-DD query parameter
+func getDateParam(req *http.Request, name string, defaultValue string) (string, bool) {
+	value := req.URL.Query().Get(name)
+
+	if value == "" {
+		return defaultValue, true
+	}
+
+	_, err := time.Parse("2006-01-02", value)
+
+	return value, err == nil
+}
+
+func getMatchup(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	teams := strings.Split(params["teams"], "-")
+
+	if len(teams) != 2 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide two teams"}},
+		})
+		return
+	}
+
+	teamA := teams[0]
+	teamB := teams[1]
+
+	from, fromOk := getDateParam(req, "from", "0001-01-01")
+	to, toOk := getDateParam(req, "to", "9999-12-31")
+
+	if !fromOk || !toOk {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Dates must be in YYYY-MM-DD format"}},
+		})
+		return
+	}
+
+	games, err := loadMatchupGames(teamA, teamB, from, to)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+	if len(games) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	lastGameDate := games[len(games)-1].Date
+
+	response := MatchupResponse{
+		TeamA: MatchupTeam{
+			Symbol:       teamA,
+			FullTeamName: getTeamNameData(teamA, lastGameDate).FullName,
+		},
+		TeamB: MatchupTeam{
+			Symbol:       teamB,
+			FullTeamName: getTeamNameData(teamB, lastGameDate).FullName,
+		},
+		From:    from,
+		To:      to,
+		Matchup: computeMatchup(teamA, games),
+		Games:   []MatchupGameSummary{},
+	}
+
+	for _, game := range games {
+		_, _, winner := game.resultFor(teamA)
+
+		response.Games = append(response.Games, MatchupGameSummary{
+			Date:              game.Date.Format("2006-01-02"),
+			NumberOfGame:      game.NumberOfGame,
+			VisitingTeam:      game.VisitingTeam,
+			HomeTeam:          game.HomeTeam,
+			VisitingTeamScore: game.VisitingTeamScore,
+			HomeTeamScore:     game.HomeTeamScore,
+			ParkID:            game.ParkID,
+			Winner:            winner,
+		})
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
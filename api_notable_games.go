@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func getNotableGames(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	isNotable, ok := NotableGameChecks[query.Get("notable")]
+
+	if !ok {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide notable as one of no_hitter, shutout, walk_off, extra_innings, comeback, blowout"}},
+		})
+		return
+	}
+
+	idScheme, ok := getIDScheme(req)
+
+	if !ok {
+		writeIDSchemeError(w)
+		return
+	}
+
+	season := 0
+	team := query.Get("team")
+
+	if query.Get("season") != "" {
+		season = parseInt(query.Get("season"))
+	}
+
+	if season < 0 || (season == 0 && team == "") {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a season or a team"}},
+		})
+		return
+	}
+
+	games, err := loadSeasonGames(season, team)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	var notable []Game
+
+	for i := range games {
+		if isNotable(&games[i]) {
+			notable = append(notable, games[i])
+		}
+	}
+
+	writeGameSummaries(w, idScheme, notable)
+}
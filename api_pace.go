@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+type PaceBucketData struct {
+	// Games lasting from this many minutes up to the next bucket
+	FromMinutes int `json:"from_mins"`
+	Games       int `json:"games"`
+}
+
+type PaceGroup struct {
+	Key               string  `json:"key"`
+	Name              string  `json:"name"`
+	Games             int     `json:"games"`
+	AverageMinutes    float64 `json:"average_mins"`
+	P10Minutes        float64 `json:"p10_mins"`
+	MedianMinutes     float64 `json:"median_mins"`
+	P90Minutes        float64 `json:"p90_mins"`
+	AverageOuts       float64 `json:"average_outs"`
+	MinutesPerOut     float64 `json:"mins_per_out"`
+	NineInningMinutes float64 `json:"nine_inning_mins"`
+	// Change of mins_per_out from the previous group, only when grouping by season
+	MinutesPerOutChange *float64         `json:"mins_per_out_change,omitempty"`
+	Distribution        []PaceBucketData `json:"distribution"`
+}
+
+type PaceResponse struct {
+	GroupBy string      `json:"group_by"`
+	Season  int         `json:"season"`
+	Team    string      `json:"team"`
+	Park    string      `json:"venue_id"`
+	Groups  []PaceGroup `json:"groups"`
+}
+
+func getPaceGroup(total *PaceTotals, filter *PaceFilter) PaceGroup {
+	group := PaceGroup{
+		Key:               total.Key,
+		Name:              total.Key,
+		Games:             total.Games,
+		AverageMinutes:    roundTo(total.AverageMinutes, 1),
+		AverageOuts:       roundTo(total.AverageOuts, 1),
+		MinutesPerOut:     roundTo(total.MinutesPerOut, 3),
+		NineInningMinutes: roundTo(total.MinutesPerOut*regulationOuts, 1),
+		Distribution:      []PaceBucketData{},
+	}
+
+	if len(total.Percentiles) == 3 {
+		group.P10Minutes = roundTo(total.Percentiles[0], 1)
+		group.MedianMinutes = roundTo(total.Percentiles[1], 1)
+		group.P90Minutes = roundTo(total.Percentiles[2], 1)
+	}
+
+	date := getSeasonEnd(filter.Season)
+
+	if filter.Season == 0 {
+		date = time.Now()
+	}
+
+	switch filter.GroupBy {
+	case "team":
+		group.Name = getTeamNameData(total.Key, date).FullName
+	case "park":
+		if park, ok := PARKS[total.Key]; ok {
+			group.Name = park.Name
+		}
+	}
+
+	return group
+}
+
+func getPace(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	filter := PaceFilter{
+		GroupBy: query.Get("group_by"),
+		Team:    query.Get("team"),
+		Park:    query.Get("park"),
+	}
+
+	if filter.GroupBy == "" {
+		filter.GroupBy = "season"
+	}
+
+	if value := query.Get("season"); value != "" {
+		filter.Season = parseInt(value)
+	}
+
+	if _, ok := PaceGroupings[filter.GroupBy]; !ok || filter.Season < 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "group_by must be one of season, team, park, umpire, umpire_crew, pitchers_used and season must be a year"}},
+		})
+		return
+	}
+
+	totals, err := loadPace(&filter)
+
+	var buckets []PaceBucket
+
+	if err == nil {
+		buckets, err = loadPaceDistribution(&filter)
+	}
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(totals) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	response := PaceResponse{
+		GroupBy: filter.GroupBy,
+		Season:  filter.Season,
+		Team:    filter.Team,
+		Park:    filter.Park,
+		Groups:  []PaceGroup{},
+	}
+
+	indexes := make(map[string]int)
+
+	for i := range totals {
+		group := getPaceGroup(&totals[i], &filter)
+
+		if filter.GroupBy == "season" && i > 0 {
+			change := roundTo(totals[i].MinutesPerOut-totals[i-1].MinutesPerOut, 3)
+			group.MinutesPerOutChange = &change
+		}
+
+		indexes[group.Key] = len(response.Groups)
+		response.Groups = append(response.Groups, group)
+	}
+
+	for _, bucket := range buckets {
+		if i, ok := indexes[bucket.Key]; ok {
+			response.Groups[i].Distribution = append(response.Groups[i].Distribution, PaceBucketData{
+				FromMinutes: bucket.Minutes,
+				Games:       bucket.Games,
+			})
+		}
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
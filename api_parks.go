@@ -0,0 +1,268 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type ParkSummary struct {
+	ParkID    string `json:"venue_id"`
+	Name      string `json:"name"`
+	Nickname  string `json:"nickname"`
+	City      string `json:"city"`
+	State     string `json:"state"`
+	League    string `json:"league"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+	URL       string `json:"url"`
+}
+
+type ParkSeason struct {
+	Season                  int      `json:"season"`
+	Games                   int      `json:"games"`
+	AverageAttendance       *float64 `json:"average_attendance"`
+	AverageGameLengthInMins *float64 `json:"average_game_length_in_mins"`
+}
+
+type ParkHomeTeam struct {
+	Symbol       string `json:"symbol"`
+	FullTeamName string `json:"full_team_name"`
+	FirstGame    string `json:"first_game"`
+	LastGame     string `json:"last_game"`
+	Games        int    `json:"games"`
+}
+
+type ParkDetails struct {
+	ParkSummary
+	Games                   int            `json:"games"`
+	AverageAttendance       *float64       `json:"average_attendance"`
+	AverageGameLengthInMins *float64       `json:"average_game_length_in_mins"`
+	Seasons                 []ParkSeason   `json:"seasons"`
+	HomeTeams               []ParkHomeTeam `json:"home_teams"`
+}
+
+type ParksResponse struct {
+	Parks []ParkSummary `json:"venues"`
+}
+
+type ParkResponse struct {
+	Park ParkDetails `json:"venue"`
+}
+
+// Rounded to one decimal place, nil when there was nothing to average
+func roundedNullFloat(value sql.NullFloat64) *float64 {
+	if !value.Valid {
+		return nil
+	}
+
+	rounded := math.Round(value.Float64*10) / 10
+
+	return &rounded
+}
+
+func getParkSummary(park *RawPark) ParkSummary {
+	return ParkSummary{
+		ParkID:    park.ParkID,
+		Name:      park.Name,
+		Nickname:  park.Nickname,
+		City:      park.City,
+		State:     park.State,
+		League:    park.League,
+		StartDate: formatNullDate(park.StartDate),
+		EndDate:   formatNullDate(park.EndDate),
+		URL:       getParkURL(park.ParkID),
+	}
+}
+
+// Lists all parks, or only parks in use on the date when one is given
+func getParks(w http.ResponseWriter, req *http.Request) {
+	dateParam := req.URL.Query().Get("date")
+
+	var date time.Time
+
+	if dateParam != "" {
+		var err error
+		date, err = time.Parse("2006-01-02", dateParam)
+
+		if err != nil {
+			w.WriteHeader(400)
+
+			json.NewEncoder(w).Encode(ResponseErrors{
+				Errors: []Error{{Message: "Must provide a date in YYYY-MM-DD format"}},
+			})
+			return
+		}
+	}
+
+	parks := []ParkSummary{}
+
+	for _, park := range PARKS {
+		if dateParam == "" || park.InUse(date) {
+			parks = append(parks, getParkSummary(park))
+		}
+	}
+
+	sort.Slice(parks, func(i, j int) bool {
+		return parks[i].ParkID < parks[j].ParkID
+	})
+
+	json.NewEncoder(w).Encode(ParksResponse{
+		Parks: parks,
+	})
+}
+
+func getPark(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	park, ok := PARKS[params["id"]]
+
+	if !ok {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "There is no venue with that id"}},
+		})
+		return
+	}
+
+	totals, err := loadParkTotals(park.ParkID)
+
+	var seasons []ParkGamesSummary
+	var homeTeams []ParkHomeTeamSummary
+
+	if err == nil {
+		seasons, err = loadParkSeasons(park.ParkID)
+	}
+
+	if err == nil {
+		homeTeams, err = loadParkHomeTeams(park.ParkID)
+	}
+
+	if err != nil {
+		w.WriteHeader(500)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Could not load venue games"}},
+		})
+		return
+	}
+
+	details := ParkDetails{
+		ParkSummary:             getParkSummary(park),
+		Games:                   totals.Games,
+		AverageAttendance:       roundedNullFloat(totals.AverageAttendance),
+		AverageGameLengthInMins: roundedNullFloat(totals.AverageGameLengthInMins),
+		Seasons:                 []ParkSeason{},
+		HomeTeams:               []ParkHomeTeam{},
+	}
+
+	for _, season := range seasons {
+		details.Seasons = append(details.Seasons, ParkSeason{
+			Season:                  season.Season,
+			Games:                   season.Games,
+			AverageAttendance:       roundedNullFloat(season.AverageAttendance),
+			AverageGameLengthInMins: roundedNullFloat(season.AverageGameLengthInMins),
+		})
+	}
+
+	for _, team := range homeTeams {
+		details.HomeTeams = append(details.HomeTeams, ParkHomeTeam{
+			Symbol:       team.TeamSymbol,
+			FullTeamName: getTeamNameData(team.TeamSymbol, team.LastGame).FullName,
+			FirstGame:    team.FirstGame.Format("2006-01-02"),
+			LastGame:     team.LastGame.Format("2006-01-02"),
+			Games:        team.Games,
+		})
+	}
+
+	json.NewEncoder(w).Encode(ParkResponse{
+		Park: details,
+	})
+}
+
+type ParkFactorsResponse struct {
+	ParkID  string        `json:"venue_id"`
+	Years   int           `json:"years"`
+	Seasons []ParkFactors `json:"seasons"`
+}
+
+func getParkFactors(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	park, ok := PARKS[params["id"]]
+
+	if !ok {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "There is no venue with that id"}},
+		})
+		return
+	}
+
+	years := 3
+	season := 0
+
+	if value := req.URL.Query().Get("years"); value != "" {
+		years = parseInt(value)
+	}
+
+	if value := req.URL.Query().Get("season"); value != "" {
+		season = parseInt(value)
+	}
+
+	if years < 1 || years > 10 || season < 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Years must be between 1 and 10 and season must be a year"}},
+		})
+		return
+	}
+
+	splits, err := loadParkFactorSplits(park.ParkID)
+
+	if err != nil {
+		w.WriteHeader(500)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Could not load venue games"}},
+		})
+		return
+	}
+
+	factors := computeParkFactors(splits, years)
+
+	if season != 0 {
+		var seasonFactors []ParkFactors
+
+		for _, factor := range factors {
+			if factor.Season == season {
+				seasonFactors = append(seasonFactors, factor)
+			}
+		}
+
+		if len(seasonFactors) == 0 {
+			w.WriteHeader(404)
+
+			json.NewEncoder(w).Encode(ResponseErrors{
+				Errors: []Error{{Message: "No games were found"}},
+			})
+			return
+		}
+
+		factors = seasonFactors
+	}
+
+	json.NewEncoder(w).Encode(ParkFactorsResponse{
+		ParkID:  park.ParkID,
+		Years:   years,
+		Seasons: factors,
+	})
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type PersonPlace struct {
+	Date    string `json:"date"`
+	City    string `json:"city"`
+	State   string `json:"state"`
+	Country string `json:"country"`
+}
+
+type PersonDebuts struct {
+	Player  string `json:"player"`
+	Manager string `json:"manager"`
+	Coach   string `json:"coach"`
+	Umpire  string `json:"umpire"`
+}
+
+type PersonDataSummary struct {
+	ID              string            `json:"id"`
+	FirstName       string            `json:"first_name"`
+	LastName        string            `json:"last_name"`
+	FullName        string            `json:"full_name"`
+	GivenName       string            `json:"given_name"`
+	Nickname        string            `json:"nickname"`
+	NameSuffix      string            `json:"name_suffix"`
+	Birth           PersonPlace       `json:"birth"`
+	Death           PersonPlace       `json:"death"`
+	Bats            string            `json:"bats"`
+	Throws          string            `json:"throws"`
+	Height          *int64            `json:"height"`
+	Weight          *int64            `json:"weight"`
+	Debuts          PersonDebuts      `json:"debuts"`
+	CrossReferences map[string]string `json:"cross_references"`
+}
+
+type PersonDataResponse struct {
+	Person PersonDataSummary `json:"person"`
+}
+
+type TransactionTeam struct {
+	Symbol       string `json:"symbol"`
+	FullTeamName string `json:"full_team_name"`
+	League       string `json:"league"`
+}
+
+type Transaction struct {
+	ID              string          `json:"id"`
+	Date            string          `json:"date"`
+	DateApproximate bool            `json:"date_approximate"`
+	SecondaryDate   string          `json:"secondary_date"`
+	Type            string          `json:"type"`
+	TypeName        string          `json:"type_name"`
+	FromTeam        TransactionTeam `json:"from_team"`
+	ToTeam          TransactionTeam `json:"to_team"`
+	DraftType       string          `json:"draft_type"`
+	DraftRound      int             `json:"draft_round"`
+	PickNumber      int             `json:"pick_number"`
+	Information     string          `json:"information"`
+}
+
+type PersonTransactionsResponse struct {
+	Person       Person        `json:"person"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+func nullIntPointer(value sql.NullInt64) *int64 {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.Int64
+}
+
+func getPerson(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	writePerson(w, params["id"])
+}
+
+func writePerson(w http.ResponseWriter, personID string) {
+	person, err := loadPerson(personID)
+
+	var crossReferences map[string]string
+
+	if err == nil {
+		crossReferences, err = loadPersonCrossReferences(personID)
+	}
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if person == nil {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "There is no person with that id"}},
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode(
+		PersonDataResponse{
+			Person: PersonDataSummary{
+				ID:         person.PersonID,
+				FirstName:  person.FirstName,
+				LastName:   person.LastName,
+				FullName:   fmt.Sprintf("%s %s", person.FirstName, person.LastName),
+				GivenName:  person.GivenName,
+				Nickname:   person.Nickname,
+				NameSuffix: person.NameSuffix,
+				Birth: PersonPlace{
+					Date:    formatNullDate(person.BirthDate),
+					City:    person.BirthCity,
+					State:   person.BirthState,
+					Country: person.BirthCountry,
+				},
+				Death: PersonPlace{
+					Date:    formatNullDate(person.DeathDate),
+					City:    person.DeathCity,
+					State:   person.DeathState,
+					Country: person.DeathCountry,
+				},
+				Bats:   person.Bats,
+				Throws: person.Throws,
+				Height: nullIntPointer(person.Height),
+				Weight: nullIntPointer(person.Weight),
+				Debuts: PersonDebuts{
+					Player:  formatNullDate(person.PlayerDebut),
+					Manager: formatNullDate(person.ManagerDebut),
+					Coach:   formatNullDate(person.CoachDebut),
+					Umpire:  formatNullDate(person.UmpireDebut),
+				},
+				CrossReferences: crossReferences,
+			},
+		},
+	)
+}
+
+func getTransactionTeam(teamSymbol string, league string, date time.Time) TransactionTeam {
+	return TransactionTeam{
+		Symbol:       teamSymbol,
+		FullTeamName: getTeamNameData(teamSymbol, date).FullName,
+		League:       league,
+	}
+}
+
+func getPersonTransactions(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	personID := params["id"]
+
+	person, err := loadPerson(personID)
+
+	var transactions []RawTransaction
+
+	if err == nil {
+		transactions, err = loadPersonTransactions(personID)
+	}
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if person == nil && len(transactions) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "There is no person with that id"}},
+		})
+		return
+	}
+
+	response := PersonTransactionsResponse{
+		Person:       Person{ID: personID},
+		Transactions: []Transaction{},
+	}
+
+	if person != nil {
+		response.Person.Name = fmt.Sprintf("%s %s", person.FirstName, person.LastName)
+	}
+
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, Transaction{
+			ID:              transaction.TransactionID,
+			Date:            formatNullDate(transaction.PrimaryDate),
+			DateApproximate: transaction.PrimaryDateApproximate != "",
+			SecondaryDate:   formatNullDate(transaction.SecondaryDate),
+			Type:            transaction.Type,
+			TypeName:        TransactionTypeNamesMap[transaction.Type],
+			FromTeam:        getTransactionTeam(transaction.FromTeam, transaction.FromLeague, transaction.PrimaryDate.Time),
+			ToTeam:          getTransactionTeam(transaction.ToTeam, transaction.ToLeague, transaction.PrimaryDate.Time),
+			DraftType:       transaction.DraftType,
+			DraftRound:      transaction.DraftRound,
+			PickNumber:      transaction.PickNumber,
+			Information:     transaction.Information,
+		})
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
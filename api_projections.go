@@ -0,0 +1,173 @@
+package main
+
+import (
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const defaultSimulations = 1000
+const maxSimulations = 10000
+
+type ProjectionsResponse struct {
+	Date           string           `json:"date"`
+	Season         int              `json:"season"`
+	Strength       string           `json:"strength"`
+	Simulations    int              `json:"simulations"`
+	Seed           int64            `json:"seed"`
+	RemainingGames int              `json:"remaining_games"`
+	Teams          []TeamProjection `json:"teams"`
+}
+
+// Current Elo ratings, teams without one are rated as average
+func getEloStrengths(date string) (map[string]float64, error) {
+	ratings, err := loadRatingsAsOf(date)
+
+	strengths := make(map[string]float64)
+
+	for _, rating := range ratings {
+		strengths[rating.Team] = rating.Rating
+	}
+
+	return strengths, err
+}
+
+// Teams that have not played by the date start from an empty record
+func addScheduleTeams(totals []TeamSeasonTotals, scheduled []TeamSeasonTotals) []TeamSeasonTotals {
+	played := make(map[string]bool)
+
+	for _, total := range totals {
+		played[total.Team] = true
+	}
+
+	for _, team := range scheduled {
+		if !played[team.Team] {
+			totals = append(totals, team)
+		}
+	}
+
+	sort.SliceStable(totals, func(i, j int) bool {
+		if totals[i].League != totals[j].League {
+			return totals[i].League < totals[j].League
+		}
+
+		return totals[i].Team < totals[j].Team
+	})
+
+	return totals
+}
+
+func getProjections(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	date, dateOk := getDateParam(req, "date", "")
+	strength := query.Get("strength")
+	simulations := defaultSimulations
+	seed := time.Now().UnixNano()
+
+	if strength == "" {
+		strength = "run_differential"
+	}
+
+	if value := query.Get("simulations"); value != "" {
+		simulations = parseInt(value)
+	}
+
+	var seedErr error
+
+	if value := query.Get("seed"); value != "" {
+		seed, seedErr = strconv.ParseInt(value, 10, 64)
+	}
+
+	if !dateOk || date == "" || (strength != "elo" && strength != "run_differential") ||
+		simulations < 1 || simulations > maxSimulations || seedErr != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a date in YYYY-MM-DD format, strength must be elo or run_differential, simulations between 1 and 10000 and seed a number"}},
+		})
+		return
+	}
+
+	parsedDate, _ := time.Parse("2006-01-02", date)
+	season := parsedDate.Year()
+
+	totals, err := loadTeamSeasonTotals(season, date)
+
+	var scheduled []TeamSeasonTotals
+	var remaining []RemainingGame
+	var eloStrengths map[string]float64
+
+	if err == nil {
+		scheduled, err = loadScheduleTeams(season)
+		totals = addScheduleTeams(totals, scheduled)
+	}
+
+	if err == nil {
+		remaining, err = loadRemainingSchedule(season, date)
+	}
+
+	if err == nil && strength == "elo" {
+		eloStrengths, err = getEloStrengths(date)
+	}
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(totals) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	var teams []ProjectionTeam
+
+	for i := range totals {
+		team := ProjectionTeam{
+			Team:   totals[i].Team,
+			League: totals[i].League,
+			Wins:   totals[i].Wins,
+			Losses: totals[i].Losses,
+			Rating: getRunDifferentialRating(&totals[i]),
+		}
+
+		if strength == "elo" {
+			team.Rating = DefaultEloConfig.InitialRating
+
+			if rating, ok := eloStrengths[team.Team]; ok {
+				team.Rating = rating
+			}
+		}
+
+		if era := findFranchiseEra(team.Team, parsedDate); era != nil {
+			team.Division = era.Division
+		}
+
+		teams = append(teams, team)
+	}
+
+	projections, simulated := simulateSeason(teams, remaining, getPlayoffFormat(season),
+		DefaultEloConfig.HomeAdvantage, simulations, rand.New(rand.NewSource(seed)))
+
+	json.NewEncoder(w).Encode(ProjectionsResponse{
+		Date:           date,
+		Season:         season,
+		Strength:       strength,
+		Simulations:    simulations,
+		Seed:           seed,
+		RemainingGames: simulated,
+		Teams:          projections,
+	})
+}
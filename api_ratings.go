@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+)
+
+type GamePreGameRatings struct {
+	VisitingRating         float64 `json:"visiting_team_rating"`
+	HomeRating             float64 `json:"home_team_rating"`
+	VisitingWinProbability float64 `json:"visiting_team_win_probability"`
+	HomeWinProbability     float64 `json:"home_team_win_probability"`
+}
+
+type TeamRatingData struct {
+	Rank     int         `json:"rank"`
+	Team     MatchupTeam `json:"team"`
+	Rating   float64     `json:"rating"`
+	LastGame string      `json:"last_game"`
+}
+
+type RatingsResponse struct {
+	Date    string           `json:"date"`
+	Ratings []TeamRatingData `json:"ratings"`
+}
+
+// Nil when ratings were not computed for the game
+func getPreGameRatings(game *Game) (*GamePreGameRatings, error) {
+	ratings, err := loadGameRatings(game)
+
+	if err != nil {
+		return nil, err
+	}
+
+	visiting, visitingOk := ratings[game.VisitingTeam]
+	home, homeOk := ratings[game.HomeTeam]
+
+	if !visitingOk || !homeOk {
+		return nil, nil
+	}
+
+	return &GamePreGameRatings{
+		VisitingRating:         roundTo(visiting.RatingBefore, 1),
+		HomeRating:             roundTo(home.RatingBefore, 1),
+		VisitingWinProbability: roundTo(visiting.WinProbability, 3),
+		HomeWinProbability:     roundTo(home.WinProbability, 3),
+	}, nil
+}
+
+func getRatings(w http.ResponseWriter, req *http.Request) {
+	date, ok := getDateParam(req, "date", time.Now().Format("2006-01-02"))
+
+	if !ok {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a date in YYYY-MM-DD format"}},
+		})
+		return
+	}
+
+	ratings, err := loadRatingsAsOf(date)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(ratings) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No ratings were found"}},
+		})
+		return
+	}
+
+	sort.SliceStable(ratings, func(i, j int) bool {
+		return ratings[i].Rating > ratings[j].Rating
+	})
+
+	response := RatingsResponse{
+		Date:    date,
+		Ratings: []TeamRatingData{},
+	}
+
+	for i, rating := range ratings {
+		response.Ratings = append(response.Ratings, TeamRatingData{
+			Rank: i + 1,
+			Team: MatchupTeam{
+				Symbol:       rating.Team,
+				FullTeamName: getTeamNameData(rating.Team, rating.LastGame).FullName,
+			},
+			Rating:   roundTo(rating.Rating, 1),
+			LastGame: rating.LastGame.Format("2006-01-02"),
+		})
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
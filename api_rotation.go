@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type RotationResponse struct {
+	Team   MatchupTeam `json:"team"`
+	Season int         `json:"season"`
+	TeamRotation
+}
+
+func (rotation *TeamRotation) personIDs() []*string {
+	var ids []*string
+
+	for i := range rotation.Rotation {
+		ids = append(ids, &rotation.Rotation[i].ID)
+	}
+
+	for i := range rotation.Pitchers {
+		ids = append(ids, &rotation.Pitchers[i].Pitcher.ID)
+	}
+
+	for i := range rotation.Starts {
+		ids = append(ids, &rotation.Starts[i].Pitcher.ID)
+	}
+
+	return ids
+}
+
+func getTeamRotation(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	team := params["team"]
+	season := parseInt(req.URL.Query().Get("season"))
+
+	idScheme, ok := getIDScheme(req)
+
+	if !ok {
+		writeIDSchemeError(w)
+		return
+	}
+
+	if season <= 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a season"}},
+		})
+		return
+	}
+
+	starts, err := loadTeamStarts(team, season)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(starts) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	response := RotationResponse{
+		Team: MatchupTeam{
+			Symbol:       team,
+			FullTeamName: getTeamNameData(team, starts[len(starts)-1].Date).FullName,
+		},
+		Season:       season,
+		TeamRotation: computeRotation(starts),
+	}
+
+	if err := rewritePersonIDs(idScheme, response.personIDs()); err != nil {
+		writeIDRewriteError(w)
+		return
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"time"
+)
+
+type ScheduleTeam struct {
+	Symbol       string `json:"symbol"`
+	FullTeamName string `json:"full_team_name"`
+	League       string `json:"league"`
+	GameNumber   int    `json:"game_number"`
+}
+
+type ScheduleGameResult struct {
+	Date              string `json:"date"`
+	NumberOfGame      string `json:"number_of_game"`
+	VisitingTeamScore int    `json:"visiting_team_runs"`
+	HomeTeamScore     int    `json:"home_team_runs"`
+}
+
+type ScheduledGame struct {
+	Date                    string       `json:"date"`
+	NumberOfGame            string       `json:"number_of_game"`
+	DayOfWeek               string       `json:"day_of_week"`
+	VisitingTeam            ScheduleTeam `json:"visiting_team"`
+	HomeTeam                ScheduleTeam `json:"home_team"`
+	DayNightIndicator       string       `json:"day_night_indicator"`
+	Postponed               bool         `json:"postponed"`
+	PostponementInformation string       `json:"postponement_information"`
+	MakeupDate              string       `json:"makeup_date"`
+	MakeupInformation       string       `json:"makeup_information"`
+	Played                  bool         `json:"played"`
+	// Games actually played, on the scheduled date or the makeup date
+	Results []ScheduleGameResult `json:"results"`
+}
+
+type ScheduleResponse struct {
+	Date      string          `json:"date"`
+	Scheduled []ScheduledGame `json:"scheduled"`
+	// Games postponed from an earlier date and made up on this date
+	MadeUp []ScheduledGame `json:"made_up"`
+}
+
+// Attributes the games of a matchup played on a date to the schedule entries played
+// that day, e.g. a regular game and a makeup played as a doubleheader. Entries take
+// the game with their own number of game first, the rest go in order of the original
+// schedule and the last entry takes any games nobody scheduled.
+func matchGameResults(game *RawScheduledGame, entries []RawScheduledGame, results []GameResult) []GameResult {
+	entries = append([]RawScheduledGame{}, entries...)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].Date.Before(entries[j].Date)
+		}
+
+		return entries[i].NumberOfGame < entries[j].NumberOfGame
+	})
+
+	matched := make([][]GameResult, len(entries))
+	claimed := make([]bool, len(results))
+
+	for i := range entries {
+		for j, result := range results {
+			if !claimed[j] && result.NumberOfGame == entries[i].NumberOfGame {
+				matched[i] = append(matched[i], result)
+				claimed[j] = true
+				break
+			}
+		}
+	}
+
+	next := 0
+
+	for i := range entries {
+		for len(matched[i]) == 0 && next < len(results) {
+			if !claimed[next] {
+				matched[i] = append(matched[i], results[next])
+				claimed[next] = true
+			}
+
+			next++
+		}
+	}
+
+	for j, result := range results {
+		if !claimed[j] && len(entries) > 0 {
+			matched[len(entries)-1] = append(matched[len(entries)-1], result)
+		}
+	}
+
+	for i := range entries {
+		if entries[i].Date.Equal(game.Date) && entries[i].NumberOfGame == game.NumberOfGame {
+			return matched[i]
+		}
+	}
+
+	return nil
+}
+
+func getScheduledGame(game *RawScheduledGame) (ScheduledGame, error) {
+	var results []GameResult
+
+	if !game.Postponed() || game.MakeupDate.Valid {
+		playDate := game.Date
+
+		if game.Postponed() {
+			playDate = game.MakeupDate.Time
+		}
+
+		entries, err := loadScheduledMatchupGames(playDate, game.VisitingTeam, game.HomeTeam)
+
+		if err == nil {
+			results, err = loadGameResults(playDate, game.VisitingTeam, game.HomeTeam)
+		}
+
+		if err != nil {
+			return ScheduledGame{}, err
+		}
+
+		results = matchGameResults(game, entries, results)
+	}
+
+	makeupDate := ""
+
+	if game.MakeupDate.Valid {
+		makeupDate = game.MakeupDate.Time.Format("2006-01-02")
+	}
+
+	visitingTeamNameData := getTeamNameData(game.VisitingTeam, game.Date)
+	homeTeamNameData := getTeamNameData(game.HomeTeam, game.Date)
+
+	scheduledGame := ScheduledGame{
+		Date:         game.Date.Format("2006-01-02"),
+		NumberOfGame: game.NumberOfGame,
+		DayOfWeek:    game.DayOfWeek,
+		VisitingTeam: ScheduleTeam{
+			Symbol:       game.VisitingTeam,
+			FullTeamName: visitingTeamNameData.FullName,
+			League:       game.VisitingTeamLeague,
+			GameNumber:   game.VisitingGameNumber,
+		},
+		HomeTeam: ScheduleTeam{
+			Symbol:       game.HomeTeam,
+			FullTeamName: homeTeamNameData.FullName,
+			League:       game.HomeTeamLeague,
+			GameNumber:   game.HomeTeamGameNumber,
+		},
+		DayNightIndicator:       game.DayNightIndicator,
+		Postponed:               game.Postponed(),
+		PostponementInformation: game.PostponementInformation,
+		MakeupDate:              makeupDate,
+		MakeupInformation:       game.MakeupInformation,
+		Played:                  len(results) > 0,
+		Results:                 []ScheduleGameResult{},
+	}
+
+	for _, result := range results {
+		scheduledGame.Results = append(scheduledGame.Results, ScheduleGameResult{
+			Date:              result.Date.Format("2006-01-02"),
+			NumberOfGame:      result.NumberOfGame,
+			VisitingTeamScore: result.VisitingTeamScore,
+			HomeTeamScore:     result.HomeTeamScore,
+		})
+	}
+
+	return scheduledGame, nil
+}
+
+func getSchedule(w http.ResponseWriter, req *http.Request) {
+	date := req.URL.Query().Get("date")
+
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a date in YYYY-MM-DD format"}},
+		})
+		return
+	}
+
+	scheduled, err := loadScheduledGames(date)
+
+	if err == nil {
+		var madeUp []RawScheduledGame
+		madeUp, err = loadMadeUpGames(date)
+		scheduled = append(scheduled, madeUp...)
+	}
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(scheduled) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were scheduled"}},
+		})
+		return
+	}
+
+	response := ScheduleResponse{
+		Date:      date,
+		Scheduled: []ScheduledGame{},
+		MadeUp:    []ScheduledGame{},
+	}
+
+	for _, game := range scheduled {
+		scheduledGame, err := getScheduledGame(&game)
+
+		if err != nil {
+			w.WriteHeader(500)
+
+			json.NewEncoder(w).Encode(ResponseErrors{
+				Errors: []Error{{Message: "Could not load game results"}},
+			})
+			return
+		}
+
+		if scheduledGame.Date == date {
+			response.Scheduled = append(response.Scheduled, scheduledGame)
+		} else {
+			response.MadeUp = append(response.MadeUp, scheduledGame)
+		}
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type SeasonChampion struct {
+	League string      `json:"league"`
+	Team   MatchupTeam `json:"team"`
+	Wins   int         `json:"wins"`
+	Losses int         `json:"losses"`
+	Pct    float64     `json:"pct"`
+}
+
+type SeasonAcquisitionData struct {
+	Code  string `json:"code"`
+	Name  string `json:"name"`
+	Games int    `json:"games"`
+}
+
+type SeasonSummaryResponse struct {
+	Season              int                     `json:"season"`
+	Games               int                     `json:"games"`
+	Teams               int                     `json:"teams"`
+	OpeningDate         string                  `json:"opening_date"`
+	ClosingDate         string                  `json:"closing_date"`
+	TotalAttendance     int64                   `json:"total_attendance"`
+	AverageGameTime     *float64                `json:"average_game_length_in_mins"`
+	ExtraInningsGames   int                     `json:"extra_innings_games"`
+	LeagueChampions     []SeasonChampion        `json:"league_champions"`
+	AcquisitionCoverage []SeasonAcquisitionData `json:"acquisition"`
+}
+
+func getSeason(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	season := parseInt(params["year"])
+
+	if season <= 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Season must be a year"}},
+		})
+		return
+	}
+
+	totals, err := loadSeasonTotals(season)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if totals.Games == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	teams, err := loadTeamSeasonTotals(season, fmt.Sprintf("%d-12-31", season))
+
+	var acquisition []SeasonAcquisition
+
+	if err == nil {
+		acquisition, err = loadSeasonAcquisition(season)
+	}
+
+	if err != nil {
+		w.WriteHeader(500)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Could not load the season"}},
+		})
+		return
+	}
+
+	response := SeasonSummaryResponse{
+		Season:              season,
+		Games:               totals.Games,
+		Teams:               len(teams),
+		OpeningDate:         formatNullDate(totals.OpeningDate),
+		ClosingDate:         formatNullDate(totals.ClosingDate),
+		TotalAttendance:     totals.Attendance,
+		AverageGameTime:     roundedNullFloat(totals.AverageTimeOfGame),
+		ExtraInningsGames:   totals.ExtraInningsGames,
+		LeagueChampions:     []SeasonChampion{},
+		AcquisitionCoverage: []SeasonAcquisitionData{},
+	}
+
+	for _, team := range findLeagueChampions(teams) {
+		response.LeagueChampions = append(response.LeagueChampions, SeasonChampion{
+			League: team.League,
+			Team: MatchupTeam{
+				Symbol:       team.Team,
+				FullTeamName: getTeamNameData(team.Team, getSeasonEnd(season)).FullName,
+			},
+			Wins:   team.Wins,
+			Losses: team.Losses,
+			Pct:    roundTo(winningPct(team.Wins, team.Losses), 3),
+		})
+	}
+
+	for _, row := range acquisition {
+		response.AcquisitionCoverage = append(response.AcquisitionCoverage, SeasonAcquisitionData{
+			Code:  row.Code,
+			Name:  AcquisitionNamesMap[row.Code],
+			Games: row.Games,
+		})
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type SeriesData struct {
+	ID       string               `json:"id"`
+	Opponent MatchupTeam          `json:"opponent"`
+	Home     bool                 `json:"home"`
+	ParkID   string               `json:"venue_id"`
+	ParkURL  string               `json:"venue_url"`
+	From     string               `json:"from"`
+	To       string               `json:"to"`
+	Result   string               `json:"result"`
+	Swept    bool                 `json:"swept"`
+	Record   MatchupRecord        `json:"record"`
+	Games    []MatchupGameSummary `json:"games"`
+}
+
+type SeriesResponse struct {
+	Team    MatchupTeam   `json:"team"`
+	Season  int           `json:"season"`
+	Summary SeriesSummary `json:"summary"`
+	Series  []SeriesData  `json:"series"`
+}
+
+func getTeamSeries(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	team := params["team"]
+	season := parseInt(req.URL.Query().Get("season"))
+
+	if season <= 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a season"}},
+		})
+		return
+	}
+
+	games, err := loadTeamSeasonGames(team, season)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(games) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	series := detectSeries(team, games)
+
+	response := SeriesResponse{
+		Team: MatchupTeam{
+			Symbol:       team,
+			FullTeamName: getTeamNameData(team, games[len(games)-1].Date).FullName,
+		},
+		Season:  season,
+		Summary: summarizeSeries(series),
+		Series:  []SeriesData{},
+	}
+
+	for _, s := range series {
+		data := SeriesData{
+			ID: s.ID,
+			Opponent: MatchupTeam{
+				Symbol:       s.Opponent,
+				FullTeamName: getTeamNameData(s.Opponent, s.From).FullName,
+			},
+			Home:    s.HomeTeam == team,
+			ParkID:  s.ParkID,
+			ParkURL: getParkURL(s.ParkID),
+			From:    s.From.Format("2006-01-02"),
+			To:      s.To.Format("2006-01-02"),
+			Result:  s.Result(),
+			Swept:   s.Swept(),
+			Record:  s.Record,
+			Games:   []MatchupGameSummary{},
+		}
+
+		for _, game := range s.Games {
+			_, _, winner := game.resultFor(team)
+
+			data.Games = append(data.Games, MatchupGameSummary{
+				Date:              game.Date.Format("2006-01-02"),
+				NumberOfGame:      game.NumberOfGame,
+				VisitingTeam:      game.VisitingTeam,
+				HomeTeam:          game.HomeTeam,
+				VisitingTeamScore: game.VisitingTeamScore,
+				HomeTeamScore:     game.HomeTeamScore,
+				ParkID:            game.ParkID,
+				Winner:            winner,
+			})
+		}
+
+		response.Series = append(response.Series, data)
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
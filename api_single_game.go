@@ -2,7 +2,6 @@ package main
 
 import (
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
 
@@ -23,11 +22,11 @@ type GameSummaryTeam struct {
 }
 
 type GameSummaryPark struct {
-	// Add URL
 	ParkID string `json:"venue_id"`
 	Name   string `json:"name"`
 	City   string `json:"city"`
 	State  string `json:"state"`
+	URL    string `json:"url"`
 }
 
 type GameSummary struct {
@@ -46,10 +45,23 @@ type GameSummary struct {
 	SavingPitcher        Person          `json:"saving_pitcher"`
 	GameWinningRBIBatter Person          `json:"game_winning_rbi_batter"`
 	Park                 GameSummaryPark `json:"venue"`
+	// Elo ratings before the game, only on single game lookups
+	PreGameRatings *GamePreGameRatings `json:"pre_game_ratings,omitempty"`
 	// line score
 	// team names
 }
 
+func (game *GameSummary) personIDs() []*string {
+	return []*string{
+		&game.VisitingTeam.Manager.ID,
+		&game.HomeTeam.Manager.ID,
+		&game.WinningPitcher.ID,
+		&game.LosingPitcher.ID,
+		&game.SavingPitcher.ID,
+		&game.GameWinningRBIBatter.ID,
+	}
+}
+
 type GameSummaryResponse struct {
 	Games []GameSummary `json:"games"`
 }
@@ -60,6 +72,13 @@ func getGameSummary(w http.ResponseWriter, req *http.Request) {
 	date := params["date"]
 	teams := strings.Split(params["teams"], "@")
 
+	idScheme, ok := getIDScheme(req)
+
+	if !ok {
+		writeIDSchemeError(w)
+		return
+	}
+
 	if len(teams) != 2 {
 		w.WriteHeader(400)
 
@@ -91,104 +110,133 @@ func getGameSummary(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	var data []GameSummary
+	data, err := getGameSummaries(idScheme, games)
+
+	if err != nil {
+		writeIDRewriteError(w)
+		return
+	}
 
-	for _, game := range games {
-		// TODO: Move it away from here
-		visitingFullTeamName := ""
-		visitingTeamName := ""
-		visitingTeamLocation := ""
-		visitingTeamData, ok := TEAMS[game.VisitingTeam]
+	for i := range data {
+		if data[i].PreGameRatings, err = getPreGameRatings(&games[i]); err != nil {
+			w.WriteHeader(500)
 
-		if ok {
-			visitingFullTeamName = fmt.Sprintf("%s %s", visitingTeamData.Location, visitingTeamData.Name)
-			visitingTeamLocation = visitingTeamData.Location
-			visitingTeamName = visitingTeamData.Name
+			json.NewEncoder(w).Encode(ResponseErrors{
+				Errors: []Error{{Message: "Could not load ratings"}},
+			})
+			return
 		}
+	}
 
-		homeFullTeamName := ""
-		homeTeamName := ""
-		homeTeamLocation := ""
-		homeTeamData, ok := TEAMS[game.HomeTeam]
+	json.NewEncoder(w).Encode(GameSummaryResponse{
+		Games: data,
+	})
+}
 
-		if ok {
-			homeFullTeamName = fmt.Sprintf("%s %s", homeTeamData.Location, homeTeamData.Name)
-			homeTeamLocation = homeTeamData.Location
-			homeTeamName = homeTeamData.Name
-		}
+func getGameSummaryData(game *Game) GameSummary {
+	visitingTeamNameData := getTeamNameData(game.VisitingTeam, game.Date)
+	homeTeamNameData := getTeamNameData(game.HomeTeam, game.Date)
 
-		parkName := ""
-		parkCity := ""
-		parkState := ""
-		park, ok := PARKS[game.ParkID]
+	parkName := ""
+	parkCity := ""
+	parkState := ""
+	park, ok := PARKS[game.ParkID]
 
-		if ok {
-			parkName = park.Name
-			parkCity = park.City
-			parkState = park.State
-		}
+	if ok {
+		parkName = park.Name
+		parkCity = park.City
+		parkState = park.State
+	}
 
-		data = append(data, GameSummary{
-			Date:         game.Date.Format("2006-01-02"),
-			NumberOfGame: game.NumberOfGame,
-			DayOfWeek:    game.DayOfWeek,
-			Park: GameSummaryPark{
-				ParkID: game.ParkID,
-				Name:   parkName,
-				City:   parkCity,
-				State:  parkState,
-			},
-			VisitingTeam: GameSummaryTeam{
-				Symbol:       game.VisitingTeam,
-				TeamName:     visitingTeamName,
-				TeamLocation: visitingTeamLocation,
-				FullTeamName: visitingFullTeamName,
-				League:       game.VisitingTeamLeague,
-				GameNumber:   game.VisitingGameNumber,
-				Score:        game.VisitingTeamScore,
-				Hits:         game.VisitingH,
-				Errors:       game.VisitingErrors,
-				Manager: Person{
-					ID:   game.VisitingManagerID,
-					Name: game.VisitingManagerName,
-				},
+	return GameSummary{
+		Date:         game.Date.Format("2006-01-02"),
+		NumberOfGame: game.NumberOfGame,
+		DayOfWeek:    game.DayOfWeek,
+		Park: GameSummaryPark{
+			ParkID: game.ParkID,
+			Name:   parkName,
+			City:   parkCity,
+			State:  parkState,
+			URL:    getParkURL(game.ParkID),
+		},
+		VisitingTeam: GameSummaryTeam{
+			Symbol:       game.VisitingTeam,
+			TeamName:     visitingTeamNameData.Name,
+			TeamLocation: visitingTeamNameData.Location,
+			FullTeamName: visitingTeamNameData.FullName,
+			League:       game.VisitingTeamLeague,
+			GameNumber:   game.VisitingGameNumber,
+			Score:        game.VisitingTeamScore,
+			Hits:         game.VisitingH,
+			Errors:       game.VisitingErrors,
+			Manager: Person{
+				ID:   game.VisitingManagerID,
+				Name: game.VisitingManagerName,
 			},
-			HomeTeam: GameSummaryTeam{
-				Symbol:       game.HomeTeam,
-				TeamName:     homeTeamName,
-				TeamLocation: homeTeamLocation,
-				FullTeamName: homeFullTeamName,
-				League:       game.HomeTeamLeague,
-				GameNumber:   game.HomeTeamGameNumber,
-				Score:        game.HomeTeamScore,
-				Hits:         game.HomeH,
-				Errors:       game.HomeErrors,
-				Manager: Person{
-					ID:   game.HomeManagerID,
-					Name: game.HomeManagerName,
-				},
+		},
+		HomeTeam: GameSummaryTeam{
+			Symbol:       game.HomeTeam,
+			TeamName:     homeTeamNameData.Name,
+			TeamLocation: homeTeamNameData.Location,
+			FullTeamName: homeTeamNameData.FullName,
+			League:       game.HomeTeamLeague,
+			GameNumber:   game.HomeTeamGameNumber,
+			Score:        game.HomeTeamScore,
+			Hits:         game.HomeH,
+			Errors:       game.HomeErrors,
+			Manager: Person{
+				ID:   game.HomeManagerID,
+				Name: game.HomeManagerName,
 			},
-			GameLengthInOuts:  game.GameLengthInOuts,
-			TimeOfGameInMins:  game.TimeOfGameInMins,
-			DayNightIndicator: game.DayNightIndicator,
-			Attendance:        game.Attendance,
-			WinningPitcher: Person{
-				ID:   game.WinningPitcherID,
-				Name: game.WinningPitcherName,
-			},
-			LosingPitcher: Person{
-				ID:   game.LosingPitcherID,
-				Name: game.LosingPitcherName,
-			},
-			SavingPitcher: Person{
-				ID:   game.SavingPitcherID,
-				Name: game.SavingPitcherName,
-			},
-			GameWinningRBIBatter: Person{
-				ID:   game.GameWinningRBIBatterID,
-				Name: game.GameWinningRBIBatterName,
-			},
-		})
+		},
+		GameLengthInOuts:  game.GameLengthInOuts,
+		TimeOfGameInMins:  game.TimeOfGameInMins,
+		DayNightIndicator: game.DayNightIndicator,
+		Attendance:        game.Attendance,
+		WinningPitcher: Person{
+			ID:   game.WinningPitcherID,
+			Name: game.WinningPitcherName,
+		},
+		LosingPitcher: Person{
+			ID:   game.LosingPitcherID,
+			Name: game.LosingPitcherName,
+		},
+		SavingPitcher: Person{
+			ID:   game.SavingPitcherID,
+			Name: game.SavingPitcherName,
+		},
+		GameWinningRBIBatter: Person{
+			ID:   game.GameWinningRBIBatterID,
+			Name: game.GameWinningRBIBatterName,
+		},
+	}
+}
+
+// Games in the GameSummary shape, with person IDs in the requested scheme
+func getGameSummaries(idScheme string, games []Game) ([]GameSummary, error) {
+	data := []GameSummary{}
+
+	for i := range games {
+		data = append(data, getGameSummaryData(&games[i]))
+	}
+
+	var ids []*string
+
+	for i := range data {
+		ids = append(ids, data[i].personIDs()...)
+	}
+
+	err := rewritePersonIDs(idScheme, ids)
+
+	return data, err
+}
+
+func writeGameSummaries(w http.ResponseWriter, idScheme string, games []Game) {
+	data, err := getGameSummaries(idScheme, games)
+
+	if err != nil {
+		writeIDRewriteError(w)
+		return
 	}
 
 	json.NewEncoder(w).Encode(GameSummaryResponse{
@@ -8,14 +8,20 @@ import (
 	"github.com/gorilla/mux"
 )
 
+type StartingPitcher struct {
+	Person
+	// Nil for the pitcher's first start of the season
+	DaysRest *int `json:"days_rest"`
+}
+
 type GameLineupTeam struct {
-	Manager         Person   `json:"manager"`
-	TeamSymbol      string   `json:"team_symbol"`
-	FullTeamName    string   `json:"full_team_name"`
-	TeamName        string   `json:"team_name"`
-	TeamLocation    string   `json:"team_location"`
-	StartingPitcher Person   `json:"starting_pitcher"`
-	StartingLineup  []Player `json:"starting_lineup"`
+	Manager         Person          `json:"manager"`
+	TeamSymbol      string          `json:"team_symbol"`
+	FullTeamName    string          `json:"full_team_name"`
+	TeamName        string          `json:"team_name"`
+	TeamLocation    string          `json:"team_location"`
+	StartingPitcher StartingPitcher `json:"starting_pitcher"`
+	StartingLineup  []Player        `json:"starting_lineup"`
 }
 
 type Umpires struct {
@@ -35,6 +41,31 @@ type GameLineup struct {
 	Umpires      Umpires        `json:"umpires"`
 }
 
+func (game *GameLineup) personIDs() []*string {
+	ids := []*string{
+		&game.VisitingTeam.Manager.ID,
+		&game.VisitingTeam.StartingPitcher.ID,
+		&game.HomeTeam.Manager.ID,
+		&game.HomeTeam.StartingPitcher.ID,
+		&game.Umpires.HomePlate.ID,
+		&game.Umpires.FirstBase.ID,
+		&game.Umpires.SecondBase.ID,
+		&game.Umpires.ThirdBase.ID,
+		&game.Umpires.LeftField.ID,
+		&game.Umpires.RightField.ID,
+	}
+
+	for i := range game.VisitingTeam.StartingLineup {
+		ids = append(ids, &game.VisitingTeam.StartingLineup[i].ID)
+	}
+
+	for i := range game.HomeTeam.StartingLineup {
+		ids = append(ids, &game.HomeTeam.StartingLineup[i].ID)
+	}
+
+	return ids
+}
+
 type LineupsResponse struct {
 	Games []GameLineup `json:"games"`
 }
@@ -45,6 +76,13 @@ func getGameSummaryLineups(w http.ResponseWriter, req *http.Request) {
 	date := params["date"]
 	teams := strings.Split(params["teams"], "@")
 
+	idScheme, ok := getIDScheme(req)
+
+	if !ok {
+		writeIDSchemeError(w)
+		return
+	}
+
 	// TODO: Factor out validation for game summary endpoints
 	if len(teams) != 2 {
 		w.WriteHeader(400)
@@ -80,8 +118,8 @@ func getGameSummaryLineups(w http.ResponseWriter, req *http.Request) {
 	var data []GameLineup
 
 	for _, game := range games {
-		visitingTeamNameData := getTeamNameData(game.VisitingTeam)
-		homeTeamNameData := getTeamNameData(game.HomeTeam)
+		visitingTeamNameData := getTeamNameData(game.VisitingTeam, game.Date)
+		homeTeamNameData := getTeamNameData(game.HomeTeam, game.Date)
 
 		data = append(data, GameLineup{
 			Date:         game.Date.Format("2006-01-02"),
@@ -91,9 +129,11 @@ func getGameSummaryLineups(w http.ResponseWriter, req *http.Request) {
 					ID:   game.VisitingManagerID,
 					Name: game.VisitingManagerName,
 				},
-				StartingPitcher: Person{
-					ID:   game.VisitingStartingPitcherID,
-					Name: game.VisitingStartingPitcherName,
+				StartingPitcher: StartingPitcher{
+					Person: Person{
+						ID:   game.VisitingStartingPitcherID,
+						Name: game.VisitingStartingPitcherName,
+					},
 				},
 				StartingLineup: getVisitingBattingOrder(&game),
 				TeamName:       visitingTeamNameData.Name,
@@ -106,9 +146,11 @@ func getGameSummaryLineups(w http.ResponseWriter, req *http.Request) {
 					ID:   game.HomeManagerID,
 					Name: game.HomeManagerName,
 				},
-				StartingPitcher: Person{
-					ID:   game.HomeStartingPitcherID,
-					Name: game.HomeStartingPitcherName,
+				StartingPitcher: StartingPitcher{
+					Person: Person{
+						ID:   game.HomeStartingPitcherID,
+						Name: game.HomeStartingPitcherName,
+					},
 				},
 				StartingLineup: getHomeBattingOrder(&game),
 				TeamName:       homeTeamNameData.Name,
@@ -145,11 +187,47 @@ func getGameSummaryLineups(w http.ResponseWriter, req *http.Request) {
 		})
 	}
 
+	for i := range data {
+		if err := loadStartingPitchersDaysRest(&data[i], &games[i]); err != nil {
+			w.WriteHeader(500)
+
+			json.NewEncoder(w).Encode(ResponseErrors{
+				Errors: []Error{{Message: "Could not load days of rest"}},
+			})
+			return
+		}
+	}
+
+	var ids []*string
+
+	for i := range data {
+		ids = append(ids, data[i].personIDs()...)
+	}
+
+	if err := rewritePersonIDs(idScheme, ids); err != nil {
+		writeIDRewriteError(w)
+		return
+	}
+
 	json.NewEncoder(w).Encode(LineupsResponse{
 		Games: data,
 	})
 }
 
+func loadStartingPitchersDaysRest(lineup *GameLineup, game *Game) error {
+	var err error
+
+	lineup.VisitingTeam.StartingPitcher.DaysRest, err = loadDaysRest(game.VisitingStartingPitcherID, game.Date, game.NumberOfGame)
+
+	if err != nil {
+		return err
+	}
+
+	lineup.HomeTeam.StartingPitcher.DaysRest, err = loadDaysRest(game.HomeStartingPitcherID, game.Date, game.NumberOfGame)
+
+	return err
+}
+
 func getVisitingBattingOrder(game *Game) []Player {
 	return []Player{
 		Player{
@@ -108,8 +108,8 @@ func getGameSummaryStats(w http.ResponseWriter, req *http.Request) {
 	var data []GameSummaryStats
 
 	for _, game := range games {
-		visitingTeamNameData := getTeamNameData(game.VisitingTeam)
-		homeTeamNameData := getTeamNameData(game.HomeTeam)
+		visitingTeamNameData := getTeamNameData(game.VisitingTeam, game.Date)
+		homeTeamNameData := getTeamNameData(game.HomeTeam, game.Date)
 
 		data = append(data, GameSummaryStats{
 			Date:         game.Date.Format("2006-01-02"),
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+type StandingsTeam struct {
+	Team            MatchupTeam     `json:"team"`
+	League          string          `json:"league"`
+	Games           int             `json:"games"`
+	Wins            int             `json:"wins"`
+	Losses          int             `json:"losses"`
+	Ties            int             `json:"ties"`
+	Pct             float64         `json:"pct"`
+	GamesBack       float64         `json:"games_back"`
+	RunsScored      int             `json:"runs_scored"`
+	RunsAllowed     int             `json:"runs_allowed"`
+	RunDifferential int             `json:"run_differential"`
+	Expected        ExpectedRecords `json:"expected"`
+}
+
+type StandingsLeague struct {
+	League string          `json:"league"`
+	Teams  []StandingsTeam `json:"teams"`
+}
+
+type StandingsResponse struct {
+	Season  int               `json:"season"`
+	AsOf    string            `json:"as_of"`
+	Leagues []StandingsLeague `json:"leagues"`
+}
+
+type ExpectedResponse struct {
+	Season int    `json:"season"`
+	AsOf   string `json:"as_of"`
+	StandingsTeam
+}
+
+// Season comes from ?season= or the year of ?date=, the date defaults to the end of the season
+func getSeasonAndDate(req *http.Request) (int, string, bool) {
+	season := parseInt(req.URL.Query().Get("season"))
+	date, ok := getDateParam(req, "date", "")
+
+	if !ok {
+		return 0, "", false
+	}
+
+	if date == "" {
+		if season <= 0 {
+			return 0, "", false
+		}
+
+		return season, fmt.Sprintf("%d-12-31", season), true
+	}
+
+	if season <= 0 {
+		parsed, _ := time.Parse("2006-01-02", date)
+		season = parsed.Year()
+	}
+
+	return season, date, true
+}
+
+func getStandingsTeam(totals *TeamSeasonTotals, asOf time.Time) StandingsTeam {
+	pct := 0.0
+
+	if totals.Wins+totals.Losses > 0 {
+		pct = float64(totals.Wins) / float64(totals.Wins+totals.Losses)
+	}
+
+	return StandingsTeam{
+		Team: MatchupTeam{
+			Symbol:       totals.Team,
+			FullTeamName: getTeamNameData(totals.Team, asOf).FullName,
+		},
+		League:          totals.League,
+		Games:           totals.Games,
+		Wins:            totals.Wins,
+		Losses:          totals.Losses,
+		Ties:            totals.Ties(),
+		Pct:             math.Round(pct*1000) / 1000,
+		RunsScored:      totals.Batting.Runs,
+		RunsAllowed:     totals.OpponentBatting.Runs,
+		RunDifferential: totals.Batting.Runs - totals.OpponentBatting.Runs,
+		Expected:        computeExpectedRecords(totals),
+	}
+}
+
+func writeSeasonAndDateError(w http.ResponseWriter) {
+	w.WriteHeader(400)
+
+	json.NewEncoder(w).Encode(ResponseErrors{
+		Errors: []Error{{Message: "Must provide a season or a date in YYYY-MM-DD format"}},
+	})
+}
+
+func getStandings(w http.ResponseWriter, req *http.Request) {
+	season, asOf, ok := getSeasonAndDate(req)
+
+	if !ok {
+		writeSeasonAndDateError(w)
+		return
+	}
+
+	teams, err := loadTeamSeasonTotals(season, asOf)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(teams) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", asOf)
+
+	response := StandingsResponse{
+		Season:  season,
+		AsOf:    asOf,
+		Leagues: []StandingsLeague{},
+	}
+
+	// Teams come ordered by league
+	for _, totals := range teams {
+		last := len(response.Leagues) - 1
+
+		if last < 0 || response.Leagues[last].League != totals.League {
+			response.Leagues = append(response.Leagues, StandingsLeague{League: totals.League})
+			last++
+		}
+
+		response.Leagues[last].Teams = append(response.Leagues[last].Teams, getStandingsTeam(&totals, date))
+	}
+
+	for _, league := range response.Leagues {
+		sortStandings(league.Teams)
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
+
+// Orders teams by winning percentage and fills in games behind the leader
+func sortStandings(teams []StandingsTeam) {
+	sort.SliceStable(teams, func(i, j int) bool {
+		return teams[i].Pct > teams[j].Pct
+	})
+
+	if len(teams) == 0 {
+		return
+	}
+
+	leader := teams[0]
+
+	for i := range teams {
+		teams[i].GamesBack = float64((leader.Wins-teams[i].Wins)+(teams[i].Losses-leader.Losses)) / 2
+	}
+}
+
+func getTeamExpected(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	team := params["team"]
+
+	season, asOf, ok := getSeasonAndDate(req)
+
+	if !ok {
+		writeSeasonAndDateError(w)
+		return
+	}
+
+	teams, err := loadTeamSeasonTotals(season, asOf)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	date, _ := time.Parse("2006-01-02", asOf)
+
+	for _, totals := range teams {
+		if totals.Team == team {
+			json.NewEncoder(w).Encode(ExpectedResponse{
+				Season:        season,
+				AsOf:          asOf,
+				StandingsTeam: getStandingsTeam(&totals, date),
+			})
+			return
+		}
+	}
+
+	w.WriteHeader(404)
+
+	json.NewEncoder(w).Encode(ResponseErrors{
+		Errors: []Error{{Message: "No games were found"}},
+	})
+}
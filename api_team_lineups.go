@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type TeamLineupsResponse struct {
+	Team   MatchupTeam `json:"team"`
+	Season int         `json:"season"`
+	TeamLineupUsage
+}
+
+func (usage *TeamLineupUsage) personIDs() []*string {
+	var ids []*string
+
+	for i := range usage.MostCommonLineup {
+		ids = append(ids, &usage.MostCommonLineup[i].ID)
+	}
+
+	for i := range usage.Players {
+		ids = append(ids, &usage.Players[i].ID)
+	}
+
+	return ids
+}
+
+// The team's lineup and the opposing starting pitcher of each game
+func getTeamLineupGames(team string, games []Game) ([]LineupGame, error) {
+	var starters []string
+
+	for _, game := range games {
+		if game.HomeTeam == team {
+			starters = append(starters, game.VisitingStartingPitcherID)
+		} else {
+			starters = append(starters, game.HomeStartingPitcherID)
+		}
+	}
+
+	throws, err := loadThrows(starters)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var lineupGames []LineupGame
+
+	for i := range games {
+		lineup := getVisitingBattingOrder(&games[i])
+
+		if games[i].HomeTeam == team {
+			lineup = getHomeBattingOrder(&games[i])
+		}
+
+		lineupGames = append(lineupGames, LineupGame{
+			Lineup:                lineup,
+			OpposingStarterThrows: throws[starters[i]],
+		})
+	}
+
+	return lineupGames, nil
+}
+
+func getTeamLineups(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	team := params["team"]
+	season := parseInt(req.URL.Query().Get("season"))
+
+	idScheme, ok := getIDScheme(req)
+
+	if !ok {
+		writeIDSchemeError(w)
+		return
+	}
+
+	if season <= 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a season"}},
+		})
+		return
+	}
+
+	games, err := loadSeasonGames(season, team)
+
+	var lineupGames []LineupGame
+
+	if err == nil {
+		lineupGames, err = getTeamLineupGames(team, games)
+	}
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(games) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	response := TeamLineupsResponse{
+		Team: MatchupTeam{
+			Symbol:       team,
+			FullTeamName: getTeamNameData(team, games[len(games)-1].Date).FullName,
+		},
+		Season:          season,
+		TeamLineupUsage: computeLineupUsage(lineupGames),
+	}
+
+	if err := rewritePersonIDs(idScheme, response.personIDs()); err != nil {
+		writeIDRewriteError(w)
+		return
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+type TeamStatsResponse struct {
+	Team            MatchupTeam   `json:"team"`
+	Season          int           `json:"season"`
+	Split           string        `json:"split"`
+	Games           int           `json:"games"`
+	Wins            int           `json:"wins"`
+	Losses          int           `json:"losses"`
+	Batting         Batting       `json:"batting"`
+	Pitching        Pitching      `json:"pitching"`
+	Fielding        Fielding      `json:"fielding"`
+	OpponentBatting Batting       `json:"opponent_batting"`
+	Rates           TeamRateStats `json:"rates"`
+	// Only with ?plus=true
+	Plus *PlusStats `json:"plus,omitempty"`
+}
+
+// Splits are home, away, day, night or vs={team}
+func applyTeamStatsSplit(filter *TeamStatsFilter, split string) bool {
+	switch {
+	case split == "":
+	case split == "home" || split == "away":
+		filter.Side = split
+	case split == "day":
+		filter.DayNight = "D"
+	case split == "night":
+		filter.DayNight = "N"
+	case strings.HasPrefix(split, "vs=") && len(split) > 3:
+		filter.Opponent = split[3:]
+	default:
+		return false
+	}
+
+	return true
+}
+
+func getTeamStats(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	team := params["team"]
+	season := parseInt(req.URL.Query().Get("season"))
+	split := req.URL.Query().Get("split")
+
+	filter := TeamStatsFilter{Season: season, Team: team}
+
+	if season <= 0 || !applyTeamStatsSplit(&filter, split) {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a season and split must be home, away, day, night or vs={team}"}},
+		})
+		return
+	}
+
+	teams, err := loadTeamStats(&filter)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(teams) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	stats := teams[0]
+
+	response := TeamStatsResponse{
+		Team: MatchupTeam{
+			Symbol:       team,
+			FullTeamName: getTeamNameData(team, getSeasonEnd(season)).FullName,
+		},
+		Season:          season,
+		Split:           split,
+		Games:           stats.Games,
+		Wins:            stats.Wins,
+		Losses:          stats.Losses,
+		Batting:         stats.Batting,
+		Pitching:        stats.Pitching,
+		Fielding:        stats.Fielding,
+		OpponentBatting: stats.OpponentBatting,
+		Rates:           computeTeamRateStats(&stats),
+	}
+
+	if req.URL.Query().Get("plus") == "true" {
+		plus, err := loadTeamPlusStatsInLeague(&stats, season, filter.Side)
+
+		if err != nil {
+			w.WriteHeader(500)
+
+			json.NewEncoder(w).Encode(ResponseErrors{
+				Errors: []Error{{Message: "Could not load park factors"}},
+			})
+			return
+		}
+
+		response.Plus = &plus
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
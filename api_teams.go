@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 
 	_ "github.com/lib/pq"
 
@@ -15,12 +16,56 @@ type TeamDataResponse struct {
 }
 
 type TeamDataSummary struct {
+	TeamSymbol string    `json:"team_symbol"`
+	Founded    int       `json:"founded"`
+	League     string    `json:"league"`
+	Location   string    `json:"location"`
+	Name       string    `json:"name"`
+	FullName   string    `json:"full_name"`
+	History    []TeamEra `json:"history"`
+}
+
+type TeamEra struct {
 	TeamSymbol string `json:"team_symbol"`
-	Founded    int    `json:"founded"`
 	League     string `json:"league"`
 	Location   string `json:"location"`
 	Name       string `json:"name"`
 	FullName   string `json:"full_name"`
+	StartDate  string `json:"start_date"`
+	EndDate    string `json:"end_date"`
+}
+
+// All eras of the franchise currently using the team symbol, oldest first
+func getFranchiseHistory(teamSymbol string) []TeamEra {
+	history := []TeamEra{}
+
+	var franchiseEras []*RawFranchiseEra
+
+	for _, eras := range FRANCHISE_ERAS {
+		for _, era := range eras {
+			if era.FranchiseID == teamSymbol {
+				franchiseEras = append(franchiseEras, era)
+			}
+		}
+	}
+
+	sort.Slice(franchiseEras, func(i, j int) bool {
+		return franchiseEras[i].StartDate.Before(franchiseEras[j].StartDate)
+	})
+
+	for _, era := range franchiseEras {
+		history = append(history, TeamEra{
+			TeamSymbol: era.TeamSymbol,
+			League:     era.League,
+			Location:   era.Location,
+			Name:       era.Name,
+			FullName:   fmt.Sprintf("%s %s", era.Location, era.Name),
+			StartDate:  era.StartDate.Format("2006-01-02"),
+			EndDate:    formatNullDate(era.EndDate),
+		})
+	}
+
+	return history
 }
 
 func getTeam(w http.ResponseWriter, req *http.Request) {
@@ -48,6 +93,7 @@ func getTeam(w http.ResponseWriter, req *http.Request) {
 				Location:   teamData.Location,
 				Name:       teamData.Name,
 				FullName:   fmt.Sprintf("%s %s", teamData.Location, teamData.Name),
+				History:    getFranchiseHistory(teamData.TeamSymbol),
 			},
 		},
 	)
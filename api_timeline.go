@@ -0,0 +1,61 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type TimelineResponse struct {
+	Team   MatchupTeam `json:"team"`
+	Season int         `json:"season"`
+	TeamTimeline
+}
+
+func getTeamTimeline(w http.ResponseWriter, req *http.Request) {
+	params := mux.Vars(req)
+
+	team := params["team"]
+	season := parseInt(req.URL.Query().Get("season"))
+
+	if season <= 0 {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Must provide a season"}},
+		})
+		return
+	}
+
+	games, err := loadTeamSeasonGames(team, season)
+
+	if err != nil {
+		w.WriteHeader(400)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "Invalid input"}},
+		})
+		return
+	}
+
+	if len(games) == 0 {
+		w.WriteHeader(404)
+
+		json.NewEncoder(w).Encode(ResponseErrors{
+			Errors: []Error{{Message: "No games were found"}},
+		})
+		return
+	}
+
+	response := TimelineResponse{
+		Team: MatchupTeam{
+			Symbol:       team,
+			FullTeamName: getTeamNameData(team, games[len(games)-1].Date).FullName,
+		},
+		Season:       season,
+		TeamTimeline: computeTimeline(team, games),
+	}
+
+	json.NewEncoder(w).Encode(response)
+}
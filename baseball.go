@@ -18,8 +18,13 @@ import (
 var db *sql.DB
 var TEAMS map[string]*RawTeam
 var PARKS map[string]*RawPark
+var FRANCHISE_ERAS map[string][]*RawFranchiseEra
 var PositionSymbolsMap = make(map[int]string)
 var PositionNamesMap = make(map[int]string)
+var EjectionJobNamesMap = make(map[string]string)
+var TransactionTypeNamesMap = make(map[string]string)
+var IDSourceColumns = make(map[string][]string)
+var AcquisitionNamesMap = make(map[string]string)
 
 // add statements
 
@@ -78,12 +83,70 @@ func loadParksDataFromDB() {
 	}
 }
 
+func loadFranchiseErasFromDB() {
+	stmt := Statements["selectAllFranchiseEras"]
+
+	rows, err := stmt.Query()
+
+	if err != nil {
+		panic(err)
+	}
+
+	FRANCHISE_ERAS = make(map[string][]*RawFranchiseEra)
+
+	for rows.Next() {
+		var era RawFranchiseEra
+
+		rows.Scan(
+			&era.FranchiseID,
+			&era.TeamSymbol,
+			&era.League,
+			&era.Division,
+			&era.Location,
+			&era.Name,
+			&era.AlternateName,
+			&era.StartDate,
+			&era.EndDate,
+			&era.City,
+			&era.State,
+		)
+
+		FRANCHISE_ERAS[era.TeamSymbol] = append(FRANCHISE_ERAS[era.TeamSymbol], &era)
+	}
+}
+
 func serveAPI() {
 	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/games", getGames).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/games/search", getNotableGames).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/games/{date}/{teams}", getGameSummary).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/games/{date}/{teams}/lineups", getGameSummaryLineups).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/games/{date}/{teams}/stats", getGameSummaryStats).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/games/{date}/{teams}/ejections", getGameSummaryEjections).Methods(http.MethodGet)
 	router.HandleFunc("/api/v1/teams/{team}", getTeam).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/teams/{team}/series", getTeamSeries).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/teams/{team}/timeline", getTeamTimeline).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/teams/{team}/expected", getTeamExpected).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/teams/{team}/stats", getTeamStats).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/teams/{team}/lineups", getTeamLineups).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/teams/{team}/rotation", getTeamRotation).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/standings", getStandings).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/leaders/teams", getTeamLeaders).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/leagues/{league}/seasons/{year}", getLeagueSeason).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/seasons/{year}", getSeason).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/analytics/pace", getPace).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/analytics/innings", getInnings).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/ratings", getRatings).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/projections", getProjections).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/parks", getParks).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/parks/{id}", getPark).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/parks/{id}/factors", getParkFactors).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/matchups/{teams}", getMatchup).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/attendance", getAttendance).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/schedule", getSchedule).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/people/lookup", getPersonLookup).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/people/{id}", getPerson).Methods(http.MethodGet)
+	router.HandleFunc("/api/v1/people/{id}/transactions", getPersonTransactions).Methods(http.MethodGet)
 
 	log.Println("Serving api")
 	log.Fatal(http.ListenAndServe(":8000", commonMiddleware(router)))
@@ -113,6 +176,43 @@ func initPositionConstants() {
 	PositionNamesMap[10] = "designated hitter"
 }
 
+func initGameConstants() {
+	// How much of the game's play-by-play Retrosheet has
+	AcquisitionNamesMap["Y"] = "complete"
+	AcquisitionNamesMap["N"] = "none"
+	AcquisitionNamesMap["D"] = "derived"
+	AcquisitionNamesMap["P"] = "partial"
+}
+
+func initPeopleConstants() {
+	EjectionJobNamesMap["P"] = "player"
+	EjectionJobNamesMap["M"] = "manager"
+	EjectionJobNamesMap["C"] = "coach"
+
+	TransactionTypeNamesMap["A"] = "assigned"
+	TransactionTypeNamesMap["D"] = "rule 5 draft pick"
+	TransactionTypeNamesMap["Da"] = "amateur draft pick"
+	TransactionTypeNamesMap["F"] = "free agent signing"
+	TransactionTypeNamesMap["Fa"] = "amateur free agent signing"
+	TransactionTypeNamesMap["Fg"] = "granted free agency"
+	TransactionTypeNamesMap["J"] = "jumped teams"
+	TransactionTypeNamesMap["L"] = "loaned"
+	TransactionTypeNamesMap["Lr"] = "returned from loan"
+	TransactionTypeNamesMap["P"] = "purchased"
+	TransactionTypeNamesMap["R"] = "released"
+	TransactionTypeNamesMap["T"] = "traded"
+	TransactionTypeNamesMap["U"] = "unknown"
+	TransactionTypeNamesMap["W"] = "waiver claim"
+	TransactionTypeNamesMap["X"] = "expansion draft pick"
+	TransactionTypeNamesMap["Z"] = "voluntarily retired"
+
+	// People register columns holding IDs of other data sources
+	IDSourceColumns["mlbam"] = []string{"keymlbam", "mlbamid"}
+	IDSourceColumns["bbref"] = []string{"keybbref", "bbrefid"}
+	IDSourceColumns["lahman"] = []string{"keylahman", "lahmanid", "playerid"}
+	IDSourceColumns["fangraphs"] = []string{"keyfangraphs", "fangraphsid"}
+}
+
 func main() {
 	lumberjackLog := &lumberjack.Logger{
 		Filename:   "./baseball.log",
@@ -124,10 +224,20 @@ func main() {
 	log.SetOutput(io.MultiWriter(lumberjackLog, os.Stderr))
 
 	var loadData = flag.Bool("load-data", false, "Load game log data")
-	var gameLogsDir = flag.String("game-logs", "", "Path to game logs directory")
+	var gameLogsPath = flag.String("game-logs", "", "Path to a game log, a zip archive of game logs or a directory of either")
+	var seasonsValue = flag.String("seasons", "", "Seasons of game logs to load, e.g. 2018 or 1990-2018")
 	var teamsFile = flag.String("teams", "", "Path to teams file")
 	var parksFile = flag.String("parks", "", "Path to parks file")
+	var franchisesFile = flag.String("franchises", "", "Path to franchise names file")
 	var peopleFile = flag.String("people", "", "Path to people file")
+	var peopleRegisterFile = flag.String("people-register", "", "Path to people register file")
+	var scheduleFile = flag.String("schedule", "", "Path to schedule file")
+	var ejectionsFile = flag.String("ejections", "", "Path to ejections file")
+	var transactionsFile = flag.String("transactions", "", "Path to transactions file")
+	var computeRatings = flag.Bool("ratings", false, "Compute Elo ratings from the loaded games")
+	var eloKFactor = flag.Float64("elo-k", DefaultEloConfig.KFactor, "K-factor of Elo ratings")
+	var eloHomeAdvantage = flag.Float64("elo-home-advantage", DefaultEloConfig.HomeAdvantage, "Elo points added to the home team")
+	var eloSeasonRegression = flag.Float64("elo-season-regression", DefaultEloConfig.SeasonRegression, "Share of Elo ratings regressed to the mean between seasons")
 
 	flag.Parse()
 
@@ -136,12 +246,21 @@ func main() {
 
 	loadTeamsDataFromDB()
 	loadParksDataFromDB()
+	loadFranchiseErasFromDB()
 
 	initPositionConstants()
+	initPeopleConstants()
+	initGameConstants()
 
 	if *loadData {
-		if *gameLogsDir != "" {
-			loadGameLogs(*gameLogsDir)
+		if *gameLogsPath != "" {
+			seasons, err := parseSeasonRange(*seasonsValue)
+
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			loadGameLogs(*gameLogsPath, seasons)
 		}
 
 		if *teamsFile != "" {
@@ -152,10 +271,40 @@ func main() {
 			loadParks(*parksFile)
 		}
 
+		if *franchisesFile != "" {
+			loadFranchises(*franchisesFile)
+		}
+
 		if *peopleFile != "" {
 			loadPeople(*peopleFile)
 		}
 
+		if *peopleRegisterFile != "" {
+			loadPeopleRegister(*peopleRegisterFile)
+		}
+
+		if *scheduleFile != "" {
+			loadSchedule(*scheduleFile)
+		}
+
+		if *ejectionsFile != "" {
+			loadEjections(*ejectionsFile)
+		}
+
+		if *transactionsFile != "" {
+			loadTransactions(*transactionsFile)
+		}
+
+		// After game logs, so that new games are rated
+		if *computeRatings {
+			loadRatings(EloConfig{
+				KFactor:          *eloKFactor,
+				HomeAdvantage:    *eloHomeAdvantage,
+				SeasonRegression: *eloSeasonRegression,
+				InitialRating:    DefaultEloConfig.InitialRating,
+			})
+		}
+
 		return
 	}
 
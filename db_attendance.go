@@ -0,0 +1,41 @@
+package main
+
+import (
+	"log"
+)
+
+type AttendanceTotals struct {
+	Key               string
+	Games             int
+	TotalAttendance   int64
+	AverageAttendance float64
+}
+
+// Season 0 and empty team or park mean no filtering
+func loadAttendance(grouping string, season int, team string, park string) ([]AttendanceTotals, error) {
+	stmt := Statements["selectAttendanceBy_"+grouping]
+
+	rows, err := stmt.Query(season, team, park)
+
+	totals := []AttendanceTotals{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return totals, err
+	}
+
+	for rows.Next() {
+		var total AttendanceTotals
+
+		rows.Scan(
+			&total.Key,
+			&total.Games,
+			&total.TotalAttendance,
+			&total.AverageAttendance,
+		)
+
+		totals = append(totals, total)
+	}
+
+	return totals, nil
+}
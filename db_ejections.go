@@ -0,0 +1,41 @@
+package main
+
+import (
+	"log"
+)
+
+func loadGameEjections(gameDate string, homeTeam string) ([]RawEjection, error) {
+	stmt := Statements["selectEjectionsByGame"]
+
+	rows, err := stmt.Query(homeTeam, gameDate)
+
+	ejections := []RawEjection{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return ejections, err
+	}
+
+	for rows.Next() {
+		var ejection RawEjection
+
+		rows.Scan(
+			&ejection.GameID,
+			&ejection.Date,
+			&ejection.HomeTeam,
+			&ejection.NumberOfGame,
+			&ejection.PersonID,
+			&ejection.PersonName,
+			&ejection.Team,
+			&ejection.Job,
+			&ejection.UmpireID,
+			&ejection.UmpireName,
+			&ejection.Inning,
+			&ejection.Reason,
+		)
+
+		ejections = append(ejections, ejection)
+	}
+
+	return ejections, nil
+}
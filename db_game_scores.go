@@ -0,0 +1,103 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+type GameScore struct {
+	Date               time.Time
+	NumberOfGame       string
+	VisitingTeam       string
+	VisitingGameNumber int
+	HomeTeam           string
+	HomeTeamGameNumber int
+	VisitingTeamScore  int
+	HomeTeamScore      int
+	ParkID             string
+}
+
+// Runs scored by the team, runs allowed by it, and the winner ("" for ties)
+func (game *GameScore) resultFor(team string) (int, int, string) {
+	scored := game.HomeTeamScore
+	allowed := game.VisitingTeamScore
+
+	if game.VisitingTeam == team {
+		scored, allowed = allowed, scored
+	}
+
+	winner := ""
+
+	if game.VisitingTeamScore > game.HomeTeamScore {
+		winner = game.VisitingTeam
+	} else if game.HomeTeamScore > game.VisitingTeamScore {
+		winner = game.HomeTeam
+	}
+
+	return scored, allowed, winner
+}
+
+func (game *GameScore) opponentOf(team string) string {
+	if game.VisitingTeam == team {
+		return game.HomeTeam
+	}
+
+	return game.VisitingTeam
+}
+
+// Number of the game in the team's season
+func (game *GameScore) gameNumberOf(team string) int {
+	if game.VisitingTeam == team {
+		return game.VisitingGameNumber
+	}
+
+	return game.HomeTeamGameNumber
+}
+
+func loadGameScores(statement string, args ...interface{}) ([]GameScore, error) {
+	stmt := Statements[statement]
+
+	rows, err := stmt.Query(args...)
+
+	games := []GameScore{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return games, err
+	}
+
+	for rows.Next() {
+		var game GameScore
+
+		rows.Scan(
+			&game.Date,
+			&game.NumberOfGame,
+			&game.VisitingTeam,
+			&game.VisitingGameNumber,
+			&game.HomeTeam,
+			&game.HomeTeamGameNumber,
+			&game.VisitingTeamScore,
+			&game.HomeTeamScore,
+			&game.ParkID,
+		)
+
+		games = append(games, game)
+	}
+
+	return games, nil
+}
+
+// Games between the two teams in either park, from and to are YYYY-MM-DD dates
+func loadMatchupGames(teamA string, teamB string, from string, to string) ([]GameScore, error) {
+	return loadGameScores("selectMatchupGames", teamA, teamB, from, to)
+}
+
+// Home and road games of the team in the season, in the order they were played
+func loadTeamSeasonGames(team string, season int) ([]GameScore, error) {
+	return loadGameScores("selectTeamSeasonGames", team, season)
+}
+
+// Every game in the order it was played, doubleheaders by game number
+func loadAllGameScores() ([]GameScore, error) {
+	return loadGameScores("selectAllGameScores")
+}
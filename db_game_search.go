@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"log"
+	"strconv"
+)
+
+type GameSortField struct {
+	Expression string
+	// Postgres type the cursor value is cast to
+	Type  string
+	Value func(game *Game) string
+}
+
+var GameSortFields = map[string]GameSortField{
+	"date": {"game_date", "date", func(game *Game) string {
+		return game.Date.Format("2006-01-02")
+	}},
+	"attendance": {"attendance", "int", func(game *Game) string {
+		return strconv.Itoa(game.Attendance)
+	}},
+	"time_of_game": {"time_of_game_in_mins", "int", func(game *Game) string {
+		return strconv.Itoa(game.TimeOfGameInMins)
+	}},
+	"runs": {"(visiting_team_score + home_team_score)", "int", func(game *Game) string {
+		return strconv.Itoa(game.VisitingTeamScore + game.HomeTeamScore)
+	}},
+	"length_in_outs": {"game_length_in_outs", "int", func(game *Game) string {
+		return strconv.Itoa(game.GameLengthInOuts)
+	}},
+}
+
+// Position after the last game of a page, games are ordered by the sort field and then by these
+type GameCursor struct {
+	SortValue    string `json:"s"`
+	Date         string `json:"d"`
+	NumberOfGame string `json:"n"`
+	HomeTeam     string `json:"h"`
+}
+
+func encodeGameCursor(cursor *GameCursor) string {
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeGameCursor(value string) (*GameCursor, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+
+	if err != nil {
+		return nil, false
+	}
+
+	var cursor GameCursor
+
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, false
+	}
+
+	return &cursor, true
+}
+
+type GameSearch struct {
+	Team     string
+	Park     string
+	From     string
+	To       string
+	DayNight string
+	// Negative when not filtering
+	MinRuns    int
+	Pitcher    string
+	Umpire     string
+	Sort       string
+	Descending bool
+	Limit      int
+	Cursor     *GameCursor
+}
+
+func (search *GameSearch) cursorAfter(game *Game) *GameCursor {
+	return &GameCursor{
+		SortValue:    GameSortFields[search.Sort].Value(game),
+		Date:         game.Date.Format("2006-01-02"),
+		NumberOfGame: game.NumberOfGame,
+		HomeTeam:     game.HomeTeam,
+	}
+}
+
+// Fetches one game more than the limit, so callers can tell whether there is a next page
+func buildGameSearchQuery(search *GameSearch) (string, []interface{}) {
+	builder := newQueryBuilder("game")
+
+	if search.Team != "" {
+		builder.Where("visiting_team = ? or home_team = ?", search.Team, search.Team)
+	}
+
+	if search.Park != "" {
+		builder.Where("park_id = ?", search.Park)
+	}
+
+	if search.From != "" {
+		builder.Where("game_date >= ?", search.From)
+	}
+
+	if search.To != "" {
+		builder.Where("game_date <= ?", search.To)
+	}
+
+	if search.DayNight != "" {
+		builder.Where("day_night_indicator = ?", search.DayNight)
+	}
+
+	if search.MinRuns >= 0 {
+		builder.Where("visiting_team_score + home_team_score >= ?", search.MinRuns)
+	}
+
+	if search.Pitcher != "" {
+		builder.Where("? in (winning_pitcher_id, losing_pitcher_id, saving_pitcher_id, visiting_starting_pitcher_id, home_starting_pitcher_id)",
+			search.Pitcher)
+	}
+
+	if search.Umpire != "" {
+		builder.Where("? in (home_plate_umpire_id, first_base_umpire_id, second_base_umpire_id, third_base_umpire_id, left_field_umpire_id, right_field_umpire_id)",
+			search.Umpire)
+	}
+
+	sort := GameSortFields[search.Sort]
+
+	direction := " asc"
+	comparison := " > "
+
+	if search.Descending {
+		direction = " desc"
+		comparison = " < "
+	}
+
+	if search.Cursor != nil {
+		builder.Where("("+sort.Expression+", game_date, number_of_game, home_team)"+comparison+"(?::"+sort.Type+", ?::date, ?, ?)",
+			search.Cursor.SortValue, search.Cursor.Date, search.Cursor.NumberOfGame, search.Cursor.HomeTeam)
+	}
+
+	builder.OrderBy(
+		sort.Expression+direction,
+		"game_date"+direction,
+		"number_of_game"+direction,
+		"home_team"+direction,
+	)
+
+	builder.Limit(search.Limit + 1)
+
+	return builder.Build()
+}
+
+// Returns a page of games and the cursor of the next page, nil on the last page
+func searchGames(search *GameSearch) ([]Game, *GameCursor, error) {
+	query, args := buildGameSearchQuery(search)
+
+	rows, err := db.Query(query, args...)
+
+	games := []Game{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return games, nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		games = append(games, scanGame(rows))
+	}
+
+	if len(games) <= search.Limit {
+		return games, nil, nil
+	}
+
+	games = games[:search.Limit]
+
+	return games, search.cursorAfter(&games[len(games)-1]), nil
+}
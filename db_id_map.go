@@ -0,0 +1,77 @@
+package main
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+// Returns an empty string when the source ID is not mapped to anyone
+func loadPersonIDBySourceID(source string, sourceID string) (string, error) {
+	stmt := Statements["selectPersonIDBySourceID"]
+
+	var personID string
+
+	err := stmt.QueryRow(source, sourceID).Scan(&personID)
+
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return "", err
+	}
+
+	return personID, nil
+}
+
+// Maps Retrosheet person IDs to IDs used by the source
+func loadSourceIDs(source string, personIDs []string) (map[string]string, error) {
+	stmt := Statements["selectSourceIDsByPersonIDs"]
+
+	sourceIDs := make(map[string]string)
+
+	rows, err := stmt.Query(source, pq.Array(personIDs))
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return sourceIDs, err
+	}
+
+	for rows.Next() {
+		var personID string
+		var sourceID string
+
+		rows.Scan(&personID, &sourceID)
+
+		sourceIDs[personID] = sourceID
+	}
+
+	return sourceIDs, nil
+}
+
+func loadPersonCrossReferences(personID string) (map[string]string, error) {
+	stmt := Statements["selectIDMappingsByPerson"]
+
+	crossReferences := make(map[string]string)
+
+	rows, err := stmt.Query(personID)
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return crossReferences, err
+	}
+
+	for rows.Next() {
+		var source string
+		var sourceID string
+
+		rows.Scan(&source, &sourceID)
+
+		crossReferences[source] = sourceID
+	}
+
+	return crossReferences, nil
+}
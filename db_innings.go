@@ -0,0 +1,71 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+type GameLineScore struct {
+	Date              time.Time
+	VisitingTeam      string
+	HomeTeam          string
+	ParkID            string
+	VisitingTeamScore int
+	HomeTeamScore     int
+	VisitingLineScore string
+	HomeLineScore     string
+}
+
+// Games of the seasons from and to, inclusive. Empty values do not filter and
+// games without line scores are left out.
+func buildLineScoresQuery(from int, to int, team string, park string) (string, []interface{}) {
+	builder := newQueryBuilder("game").
+		Select("game_date", "visiting_team", "home_team", "park_id", "visiting_team_score", "home_team_score",
+			"visiting_line_score", "home_line_score").
+		Where("visiting_line_score <> '' and home_line_score <> ''").
+		Where("extract(year from game_date)::int between ? and ?", from, to)
+
+	if team != "" {
+		builder.Where("visiting_team = ? or home_team = ?", team, team)
+	}
+
+	if park != "" {
+		builder.Where("park_id = ?", park)
+	}
+
+	return builder.OrderBy("game_date", "number_of_game").Build()
+}
+
+func loadLineScores(from int, to int, team string, park string) ([]GameLineScore, error) {
+	query, args := buildLineScoresQuery(from, to, team, park)
+
+	rows, err := db.Query(query, args...)
+
+	games := []GameLineScore{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return games, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var game GameLineScore
+
+		rows.Scan(
+			&game.Date,
+			&game.VisitingTeam,
+			&game.HomeTeam,
+			&game.ParkID,
+			&game.VisitingTeamScore,
+			&game.HomeTeamScore,
+			&game.VisitingLineScore,
+			&game.HomeLineScore,
+		)
+
+		games = append(games, game)
+	}
+
+	return games, nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+// Width of the game length histogram buckets
+const paceBucketMinutes = 15
+
+type PaceGrouping struct {
+	Table string
+	Key   string
+	// Expression the groups are sorted by when their keys don't sort as text, empty otherwise
+	Order string
+}
+
+const pacePitchersUsed = "(greatest(visiting_pitchers_used, 0) + greatest(home_pitchers_used, 0))"
+
+// Every game counts once per group, team groups count it for both teams
+var PaceGroupings = map[string]PaceGrouping{
+	"season": {"game", "extract(year from game_date)::int::text", ""},
+	"team":   {"game cross join lateral unnest(array[visiting_team, home_team]) as pace_team(team)", "pace_team.team", ""},
+	"park":   {"game", "park_id", ""},
+	"umpire": {"game", "home_plate_umpire_id", ""},
+	"umpire_crew": {"game", "array_to_string(array(select umpire from unnest(array[home_plate_umpire_id, first_base_umpire_id, " +
+		"second_base_umpire_id, third_base_umpire_id]) as umpire where umpire <> '' order by umpire), ',')", ""},
+	"pitchers_used": {"game", pacePitchersUsed + "::text", pacePitchersUsed},
+}
+
+// Expressions to group by and the one to sort the groups by
+func (grouping *PaceGrouping) groupAndOrder() ([]string, string) {
+	if grouping.Order == "" {
+		return []string{"key"}, "key"
+	}
+
+	return []string{"key", grouping.Order}, grouping.Order
+}
+
+type PaceFilter struct {
+	GroupBy string
+	// Zero and empty values do not filter
+	Season int
+	Team   string
+	Park   string
+}
+
+type PaceTotals struct {
+	Key            string
+	Games          int
+	AverageMinutes float64
+	Percentiles    []float64
+	AverageOuts    float64
+	MinutesPerOut  float64
+}
+
+type PaceBucket struct {
+	Key     string
+	Minutes int
+	Games   int
+}
+
+// Games without a recorded length in minutes or outs are left out
+func newPaceQueryBuilder(filter *PaceFilter) *QueryBuilder {
+	builder := newQueryBuilder(PaceGroupings[filter.GroupBy].Table).
+		Where("time_of_game_in_mins > 0 and game_length_in_outs > 0")
+
+	if filter.Season != 0 {
+		builder.Where("extract(year from game_date)::int = ?", filter.Season)
+	}
+
+	if filter.Team != "" {
+		builder.Where("visiting_team = ? or home_team = ?", filter.Team, filter.Team)
+	}
+
+	// With a team filter only its own group counts, not its opponents'
+	if filter.Team != "" && filter.GroupBy == "team" {
+		builder.Where("pace_team.team = ?", filter.Team)
+	}
+
+	if filter.Park != "" {
+		builder.Where("park_id = ?", filter.Park)
+	}
+
+	return builder
+}
+
+func buildPaceQuery(filter *PaceFilter) (string, []interface{}) {
+	grouping := PaceGroupings[filter.GroupBy]
+	key := grouping.Key
+	groupBy, orderBy := grouping.groupAndOrder()
+
+	return newPaceQueryBuilder(filter).
+		Select(
+			key+" as key",
+			"count(*)",
+			"avg(time_of_game_in_mins)",
+			"percentile_cont(array[0.1, 0.5, 0.9]) within group (order by time_of_game_in_mins)",
+			"avg(game_length_in_outs)",
+			"sum(time_of_game_in_mins)::float / sum(game_length_in_outs)",
+		).
+		GroupBy(groupBy...).
+		OrderBy(orderBy).
+		Build()
+}
+
+func buildPaceDistributionQuery(filter *PaceFilter) (string, []interface{}) {
+	grouping := PaceGroupings[filter.GroupBy]
+	key := grouping.Key
+	groupBy, orderBy := grouping.groupAndOrder()
+
+	return newPaceQueryBuilder(filter).
+		Select(
+			key+" as key",
+			fmt.Sprintf("time_of_game_in_mins / %d * %d as bucket", paceBucketMinutes, paceBucketMinutes),
+			"count(*)",
+		).
+		GroupBy(append(groupBy, "bucket")...).
+		OrderBy(orderBy, "bucket").
+		Build()
+}
+
+func loadPace(filter *PaceFilter) ([]PaceTotals, error) {
+	query, args := buildPaceQuery(filter)
+
+	rows, err := db.Query(query, args...)
+
+	totals := []PaceTotals{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return totals, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var total PaceTotals
+
+		rows.Scan(
+			&total.Key,
+			&total.Games,
+			&total.AverageMinutes,
+			pq.Array(&total.Percentiles),
+			&total.AverageOuts,
+			&total.MinutesPerOut,
+		)
+
+		totals = append(totals, total)
+	}
+
+	return totals, nil
+}
+
+func loadPaceDistribution(filter *PaceFilter) ([]PaceBucket, error) {
+	query, args := buildPaceDistributionQuery(filter)
+
+	rows, err := db.Query(query, args...)
+
+	buckets := []PaceBucket{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return buckets, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var bucket PaceBucket
+
+		rows.Scan(&bucket.Key, &bucket.Minutes, &bucket.Games)
+
+		buckets = append(buckets, bucket)
+	}
+
+	return buckets, nil
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"database/sql"
+	"log"
+	"time"
+)
+
+type ParkGamesSummary struct {
+	Season                  int
+	Games                   int
+	AverageAttendance       sql.NullFloat64
+	AverageGameLengthInMins sql.NullFloat64
+}
+
+type ParkHomeTeamSummary struct {
+	TeamSymbol string
+	FirstGame  time.Time
+	LastGame   time.Time
+	Games      int
+}
+
+func loadParkTotals(parkID string) (ParkGamesSummary, error) {
+	stmt := Statements["selectParkTotals"]
+
+	var totals ParkGamesSummary
+
+	err := stmt.QueryRow(parkID).Scan(
+		&totals.Games,
+		&totals.AverageAttendance,
+		&totals.AverageGameLengthInMins,
+	)
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+	}
+
+	return totals, err
+}
+
+func loadParkSeasons(parkID string) ([]ParkGamesSummary, error) {
+	stmt := Statements["selectParkSeasons"]
+
+	rows, err := stmt.Query(parkID)
+
+	seasons := []ParkGamesSummary{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return seasons, err
+	}
+
+	for rows.Next() {
+		var season ParkGamesSummary
+
+		rows.Scan(
+			&season.Season,
+			&season.Games,
+			&season.AverageAttendance,
+			&season.AverageGameLengthInMins,
+		)
+
+		seasons = append(seasons, season)
+	}
+
+	return seasons, nil
+}
+
+func loadParkHomeTeams(parkID string) ([]ParkHomeTeamSummary, error) {
+	stmt := Statements["selectParkHomeTeams"]
+
+	rows, err := stmt.Query(parkID)
+
+	teams := []ParkHomeTeamSummary{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return teams, err
+	}
+
+	for rows.Next() {
+		var team ParkHomeTeamSummary
+
+		rows.Scan(
+			&team.TeamSymbol,
+			&team.FirstGame,
+			&team.LastGame,
+			&team.Games,
+		)
+
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
+
+func loadParkFactorSplits(parkID string) ([]ParkSplit, error) {
+	stmt := Statements["selectParkFactorSplits"]
+
+	rows, err := stmt.Query(parkID)
+
+	splits := []ParkSplit{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return splits, err
+	}
+
+	for rows.Next() {
+		var split ParkSplit
+
+		rows.Scan(
+			&split.Season,
+			&split.Home.Games,
+			&split.Home.Runs,
+			&split.Home.Hits,
+			&split.Home.HomeRuns,
+			&split.Home.Doubles,
+			&split.Home.Triples,
+			&split.Home.Walks,
+			&split.Home.Strikeouts,
+			&split.Road.Games,
+			&split.Road.Runs,
+			&split.Road.Hits,
+			&split.Road.HomeRuns,
+			&split.Road.Doubles,
+			&split.Road.Triples,
+			&split.Road.Walks,
+			&split.Road.Strikeouts,
+		)
+
+		splits = append(splits, split)
+	}
+
+	return splits, nil
+}
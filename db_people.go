@@ -0,0 +1,118 @@
+package main
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+// Returns nil when there is no person with that ID
+func loadPerson(personID string) (*RawPerson, error) {
+	stmt := Statements["selectPersonByID"]
+
+	var person RawPerson
+
+	err := stmt.QueryRow(personID).Scan(
+		&person.PersonID,
+		&person.LastName,
+		&person.FirstName,
+		&person.PlayerDebut,
+		&person.ManagerDebut,
+		&person.CoachDebut,
+		&person.UmpireDebut,
+		&person.GivenName,
+		&person.Nickname,
+		&person.NameSuffix,
+		&person.BirthDate,
+		&person.BirthCity,
+		&person.BirthState,
+		&person.BirthCountry,
+		&person.DeathDate,
+		&person.DeathCity,
+		&person.DeathState,
+		&person.DeathCountry,
+		&person.Bats,
+		&person.Throws,
+		&person.Height,
+		&person.Weight,
+	)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return nil, err
+	}
+
+	return &person, nil
+}
+
+func loadPersonTransactions(personID string) ([]RawTransaction, error) {
+	stmt := Statements["selectTransactionsByPerson"]
+
+	rows, err := stmt.Query(personID)
+
+	transactions := []RawTransaction{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return transactions, err
+	}
+
+	for rows.Next() {
+		var transaction RawTransaction
+
+		rows.Scan(
+			&transaction.TransactionID,
+			&transaction.PersonID,
+			&transaction.PrimaryDate,
+			&transaction.PrimaryDateApproximate,
+			&transaction.TimeOfDay,
+			&transaction.SecondaryDate,
+			&transaction.SecondaryDateApproximate,
+			&transaction.Type,
+			&transaction.FromTeam,
+			&transaction.FromLeague,
+			&transaction.ToTeam,
+			&transaction.ToLeague,
+			&transaction.DraftType,
+			&transaction.DraftRound,
+			&transaction.PickNumber,
+			&transaction.Information,
+		)
+
+		transactions = append(transactions, transaction)
+	}
+
+	return transactions, nil
+}
+
+// Throwing hand (L, R or B) of each person, people without a known hand are left out
+func loadThrows(personIDs []string) (map[string]string, error) {
+	stmt := Statements["selectThrowsByPersonIDs"]
+
+	rows, err := stmt.Query(pq.Array(personIDs))
+
+	throws := make(map[string]string)
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return throws, err
+	}
+
+	for rows.Next() {
+		var personID string
+		var hand string
+
+		rows.Scan(&personID, &hand)
+
+		if hand != "" {
+			throws[personID] = hand
+		}
+	}
+
+	return throws, nil
+}
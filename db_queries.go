@@ -1,13 +1,225 @@
 package main
 
-import "database/sql"
+import (
+	"database/sql"
+	"fmt"
+)
 
 const selectGameByDate = `select * from game where visiting_team = $1 and home_team = $2 and game_date = $3`
 const selectAllTeams = `select * from team`
 const selectAllParks = `select * from park`
+const selectAllFranchiseEras = `select * from franchise_era order by team_symbol, start_date`
+const insertFranchiseEra = `insert into franchise_era (franchise_id, team_symbol, league, division, location, name, alternate_name, start_date, end_date, city, state) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+const selectParkTotals = `select count(*), avg(attendance) filter (where attendance > 0), avg(time_of_game_in_mins) filter (where time_of_game_in_mins > 0) from game where park_id = $1`
+const selectParkSeasons = `select extract(year from game_date)::int as season, count(*), avg(attendance) filter (where attendance > 0), avg(time_of_game_in_mins) filter (where time_of_game_in_mins > 0) from game where park_id = $1 group by season order by season`
+const selectParkHomeTeams = `select home_team, min(game_date), max(game_date), count(*) from game where park_id = $1 group by home_team order by min(game_date)`
+const selectParkFactorSplits = `with home as (
+	select extract(year from game_date)::int as season, home_team as team, count(*) as games,
+	sum(greatest(visiting_team_score, 0) + greatest(home_team_score, 0)) as runs,
+	sum(greatest(visiting_h, 0) + greatest(home_h, 0)) as hits,
+	sum(greatest(visiting_hr, 0) + greatest(home_hr, 0)) as home_runs,
+	sum(greatest(visiting_2b, 0) + greatest(home_2b, 0)) as doubles,
+	sum(greatest(visiting_3b, 0) + greatest(home_3b, 0)) as triples,
+	sum(greatest(visiting_bb, 0) + greatest(home_bb, 0)) as walks,
+	sum(greatest(visiting_k, 0) + greatest(home_k, 0)) as strikeouts
+	from game where park_id = $1 group by season, home_team
+), road as (
+	select extract(year from game_date)::int as season, visiting_team as team, count(*) as games,
+	sum(greatest(visiting_team_score, 0) + greatest(home_team_score, 0)) as runs,
+	sum(greatest(visiting_h, 0) + greatest(home_h, 0)) as hits,
+	sum(greatest(visiting_hr, 0) + greatest(home_hr, 0)) as home_runs,
+	sum(greatest(visiting_2b, 0) + greatest(home_2b, 0)) as doubles,
+	sum(greatest(visiting_3b, 0) + greatest(home_3b, 0)) as triples,
+	sum(greatest(visiting_bb, 0) + greatest(home_bb, 0)) as walks,
+	sum(greatest(visiting_k, 0) + greatest(home_k, 0)) as strikeouts
+	from game where park_id <> $1 and visiting_team in (select distinct home_team from game where park_id = $1)
+	group by season, visiting_team
+)
+select home.season,
+	home.games, home.runs, home.hits, home.home_runs, home.doubles, home.triples, home.walks, home.strikeouts,
+	road.games, road.runs, road.hits, road.home_runs, road.doubles, road.triples, road.walks, road.strikeouts
+	from home join road on home.season = road.season and home.team = road.team
+	order by home.season`
+const selectMatchupGames = `select game_date, number_of_game, visiting_team, visiting_game_number, home_team, home_team_game_number, visiting_team_score, home_team_score, park_id from game
+	where ((visiting_team = $1 and home_team = $2) or (visiting_team = $2 and home_team = $1))
+	and game_date between $3 and $4
+	order by game_date, number_of_game`
+const selectTeamSeasonGames = `select game_date, number_of_game, visiting_team, visiting_game_number, home_team, home_team_game_number, visiting_team_score, home_team_score, park_id from game
+	where (visiting_team = $1 or home_team = $1) and extract(year from game_date)::int = $2
+	order by game_date, number_of_game`
+const selectTeamSeasonTotals = `with team_game as (
+	select visiting_team as team, visiting_team_league as league, visiting_team_score as runs_scored, home_team_score as runs_allowed,
+		greatest(visiting_ab, 0) as ab,
+		greatest(visiting_h, 0) as h,
+		greatest(visiting_2b, 0) as doubles,
+		greatest(visiting_3b, 0) as triples,
+		greatest(visiting_hr, 0) as hr,
+		greatest(visiting_sh, 0) as sh,
+		greatest(visiting_sf, 0) as sf,
+		greatest(visiting_hbp, 0) as hbp,
+		greatest(visiting_bb, 0) as bb,
+		greatest(visiting_ibb, 0) as ibb,
+		greatest(visiting_k, 0) as k,
+		greatest(visiting_sb, 0) as sb,
+		greatest(visiting_cs, 0) as cs,
+		greatest(visiting_gidp, 0) as gidp,
+		greatest(home_ab, 0) as opponent_ab,
+		greatest(home_h, 0) as opponent_h,
+		greatest(home_2b, 0) as opponent_doubles,
+		greatest(home_3b, 0) as opponent_triples,
+		greatest(home_hr, 0) as opponent_hr,
+		greatest(home_sh, 0) as opponent_sh,
+		greatest(home_sf, 0) as opponent_sf,
+		greatest(home_hbp, 0) as opponent_hbp,
+		greatest(home_bb, 0) as opponent_bb,
+		greatest(home_ibb, 0) as opponent_ibb,
+		greatest(home_k, 0) as opponent_k,
+		greatest(home_sb, 0) as opponent_sb,
+		greatest(home_cs, 0) as opponent_cs,
+		greatest(home_gidp, 0) as opponent_gidp
+	from game where extract(year from game_date)::int = $1 and game_date <= $2
+	union all
+	select home_team as team, home_team_league as league, home_team_score as runs_scored, visiting_team_score as runs_allowed,
+		greatest(home_ab, 0) as ab,
+		greatest(home_h, 0) as h,
+		greatest(home_2b, 0) as doubles,
+		greatest(home_3b, 0) as triples,
+		greatest(home_hr, 0) as hr,
+		greatest(home_sh, 0) as sh,
+		greatest(home_sf, 0) as sf,
+		greatest(home_hbp, 0) as hbp,
+		greatest(home_bb, 0) as bb,
+		greatest(home_ibb, 0) as ibb,
+		greatest(home_k, 0) as k,
+		greatest(home_sb, 0) as sb,
+		greatest(home_cs, 0) as cs,
+		greatest(home_gidp, 0) as gidp,
+		greatest(visiting_ab, 0) as opponent_ab,
+		greatest(visiting_h, 0) as opponent_h,
+		greatest(visiting_2b, 0) as opponent_doubles,
+		greatest(visiting_3b, 0) as opponent_triples,
+		greatest(visiting_hr, 0) as opponent_hr,
+		greatest(visiting_sh, 0) as opponent_sh,
+		greatest(visiting_sf, 0) as opponent_sf,
+		greatest(visiting_hbp, 0) as opponent_hbp,
+		greatest(visiting_bb, 0) as opponent_bb,
+		greatest(visiting_ibb, 0) as opponent_ibb,
+		greatest(visiting_k, 0) as opponent_k,
+		greatest(visiting_sb, 0) as opponent_sb,
+		greatest(visiting_cs, 0) as opponent_cs,
+		greatest(visiting_gidp, 0) as opponent_gidp
+	from game where extract(year from game_date)::int = $1 and game_date <= $2
+)
+select team, league, count(*),
+	count(*) filter (where runs_scored > runs_allowed),
+	count(*) filter (where runs_scored < runs_allowed),
+	sum(runs_scored), sum(runs_allowed),
+	sum(ab), sum(h), sum(doubles), sum(triples), sum(hr), sum(sh), sum(sf), sum(hbp), sum(bb), sum(ibb), sum(k), sum(sb), sum(cs), sum(gidp),
+	sum(opponent_ab), sum(opponent_h), sum(opponent_doubles), sum(opponent_triples), sum(opponent_hr), sum(opponent_sh), sum(opponent_sf), sum(opponent_hbp), sum(opponent_bb), sum(opponent_ibb), sum(opponent_k), sum(opponent_sb), sum(opponent_cs), sum(opponent_gidp)
+from team_game group by team, league order by league, team`
+
+// Season 0 and an empty team match every game
+const selectGamesBySeasonAndTeam = `select * from game where ($1 = 0 or extract(year from game_date)::int = $1)
+	and ($2 = '' or visiting_team = $2 or home_team = $2)
+	order by game_date, number_of_game`
+const selectMainHomePark = `select park_id from game where home_team = $1 and extract(year from game_date)::int = $2
+	group by park_id order by count(*) desc, park_id limit 1`
+const selectSeasonSummary = `select count(*), min(game_date), max(game_date),
+	coalesce(sum(attendance) filter (where attendance > 0), 0),
+	avg(time_of_game_in_mins) filter (where time_of_game_in_mins > 0),
+	count(*) filter (where case when visiting_line_score <> ''
+		then length(regexp_replace(visiting_line_score, '\(\d+\)', '0', 'g')) > scheduled_innings
+		else game_length_in_outs > 6 * scheduled_innings end)
+	from (select *, case when extract(year from game_date)::int in (2020, 2021) and number_of_game <> '0' then 7 else 9 end as scheduled_innings
+		from game where extract(year from game_date)::int = $1) game`
+const selectSeasonAcquisition = `select acquisition_information, count(*) from game where extract(year from game_date)::int = $1
+	group by acquisition_information order by count(*) desc, acquisition_information`
+const selectAllGameScores = `select game_date, number_of_game, visiting_team, visiting_game_number, home_team, home_team_game_number, visiting_team_score, home_team_score, park_id from game
+	order by game_date, number_of_game, home_team`
+const deleteTeamRatings = `delete from team_rating`
+const insertTeamRating = `insert into team_rating (team, game_date, number_of_game, opponent, rating_before, rating_after, win_probability) values ($1, $2, $3, $4, $5, $6, $7)`
+const selectRatingsAsOf = `select distinct on (team) team, game_date, rating_after from team_rating
+	where game_date <= $1 and game_date > $1::date - interval '1 year'
+	order by team, game_date desc, number_of_game desc`
+const selectGameRatings = `select team, rating_before, win_probability from team_rating where game_date = $1 and number_of_game = $2 and team in ($3, $4)`
+
+// Games of the season still to be played after the date, postponed games count on their makeup date
+const selectRemainingSchedule = `select visiting_team, home_team, case when postponement_information <> '' then makeup_date else game_date end as play_date
+	from schedule where extract(year from game_date)::int = $1
+	and (case when postponement_information <> '' then makeup_date else game_date end) > $2
+	order by play_date, home_team`
+const selectScheduleTeams = `select visiting_team, visiting_team_league from schedule where extract(year from game_date)::int = $1
+	union select home_team, home_team_league from schedule where extract(year from game_date)::int = $1
+	order by 2, 1`
+const selectThrowsByPersonIDs = `select person_id, coalesce(throws, '') from person where person_id = any($1)`
+const selectTeamStarts = `with starts as (select game_date, number_of_game,
+	case when home_team = $1 then home_team_game_number else visiting_game_number end as game_number,
+	case when home_team = $1 then home_starting_pitcher_id else visiting_starting_pitcher_id end as pitcher_id,
+	case when home_team = $1 then home_starting_pitcher_name else visiting_starting_pitcher_name end as pitcher_name
+	from game where (visiting_team = $1 or home_team = $1) and extract(year from game_date)::int = $2)
+	select game_date, number_of_game, game_number, pitcher_id, pitcher_name,
+	(select previous.game_date from game previous
+		where (previous.visiting_starting_pitcher_id = starts.pitcher_id or previous.home_starting_pitcher_id = starts.pitcher_id)
+		and extract(year from previous.game_date)::int = $2
+		and (previous.game_date < starts.game_date or (previous.game_date = starts.game_date and previous.number_of_game < starts.number_of_game))
+		order by previous.game_date desc, previous.number_of_game desc limit 1)
+	from starts order by game_date, number_of_game`
+const selectPreviousStart = `select game_date from game
+	where (visiting_starting_pitcher_id = $1 or home_starting_pitcher_id = $1)
+	and extract(year from game_date)::int = extract(year from $2::date)::int
+	and (game_date < $2 or (game_date = $2 and number_of_game < $3))
+	order by game_date desc, number_of_game desc limit 1`
 const insertTeam = `insert into team (team_symbol, founded, league, location, name) values ($1, $2, $3, $4, $5)`
 const insertPark = `insert into park (park_id, name, nickname, city, state, start_date, end_date, league) values ($1, $2, $3, $4, $5, $6, $7, $8)`
 const queryInsertPerson = `insert into person (person_id, last_name, first_name, player_debut, manager_debut, coach_debut, umpire_debut) values ($1, $2, $3, $4, $5, $6, $7)`
+const insertScheduledGame = `insert into schedule (game_date, number_of_game, day_of_week, visiting_team, visiting_team_league, visiting_game_number, home_team, home_team_league, home_team_game_number, day_night_indicator, postponement_information, makeup_information, makeup_date) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+const selectScheduleByDate = `select * from schedule where game_date = $1 order by home_team, number_of_game`
+const selectScheduleByMakeupDate = `select * from schedule where makeup_date = $1 order by home_team, game_date`
+const insertEjection = `insert into ejection (game_id, game_date, home_team, number_of_game, person_id, person_name, team, job, umpire_id, umpire_name, inning, reason) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+const selectEjectionsByGame = `select * from ejection where home_team = $1 and game_date = $2 order by number_of_game, inning`
+const insertTransaction = `insert into player_transaction (transaction_id, person_id, primary_date, primary_date_approximate, time_of_day, secondary_date, secondary_date_approximate, transaction_type, from_team, from_league, to_team, to_league, draft_type, draft_round, pick_number, information) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
+const selectTransactionsByPerson = `select * from player_transaction where person_id = $1 order by primary_date, transaction_id`
+const selectPersonByID = `select person_id, last_name, first_name, player_debut, manager_debut, coach_debut, umpire_debut,
+	coalesce(given_name, ''), coalesce(nickname, ''), coalesce(name_suffix, ''),
+	birth_date, coalesce(birth_city, ''), coalesce(birth_state, ''), coalesce(birth_country, ''),
+	death_date, coalesce(death_city, ''), coalesce(death_state, ''), coalesce(death_country, ''),
+	coalesce(bats, ''), coalesce(throws, ''), height, weight
+	from person where person_id = $1`
+const upsertRegisterPerson = `insert into person (person_id, last_name, first_name, given_name, nickname, name_suffix,
+	birth_date, birth_city, birth_state, birth_country, death_date, death_city, death_state, death_country,
+	bats, throws, height, weight)
+	values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	on conflict (person_id) do update set
+	last_name = excluded.last_name, first_name = excluded.first_name, given_name = excluded.given_name,
+	nickname = excluded.nickname, name_suffix = excluded.name_suffix,
+	birth_date = excluded.birth_date, birth_city = excluded.birth_city, birth_state = excluded.birth_state, birth_country = excluded.birth_country,
+	death_date = excluded.death_date, death_city = excluded.death_city, death_state = excluded.death_state, death_country = excluded.death_country,
+	bats = excluded.bats, throws = excluded.throws, height = excluded.height, weight = excluded.weight`
+const upsertIDMapping = `insert into id_map (source, source_id, person_id) values ($1, $2, $3) on conflict (source, source_id) do update set person_id = excluded.person_id`
+const selectPersonIDBySourceID = `select person_id from id_map where source = $1 and source_id = $2`
+const selectSourceIDsByPersonIDs = `select person_id, source_id from id_map where source = $1 and person_id = any($2)`
+const selectIDMappingsByPerson = `select source, source_id from id_map where person_id = $1`
+const selectScheduleByPlayDate = `select * from schedule where visiting_team = $2 and home_team = $3
+	and ((game_date = $1 and postponement_information = '') or makeup_date = $1)
+	order by game_date, number_of_game`
+const selectGameResults = `select game_date, number_of_game, visiting_team_score, home_team_score from game where visiting_team = $1 and home_team = $2 and game_date = $3 order by number_of_game`
+
+// Attendance can be grouped by any of these expressions
+var attendanceGroupings = map[string]string{
+	"team":        "home_team",
+	"park":        "park_id",
+	"season":      "extract(year from game_date)::int::varchar",
+	"date":        "to_char(game_date, 'YYYY-MM-DD')",
+	"day_of_week": "day_of_week",
+	"day_night":   "day_night_indicator",
+}
+
+const selectAttendance = `select %s as key, count(*), sum(attendance), avg(attendance) from game
+	where attendance > 0
+	and ($1::int = 0 or extract(year from game_date)::int = $1::int)
+	and ($2::varchar = '' or home_team = $2::varchar)
+	and ($3::varchar = '' or park_id = $3::varchar)
+	group by key order by key`
 
 var Statements = make(map[string]*sql.Stmt)
 
@@ -21,6 +233,80 @@ func prepareQueries(db *sql.DB) {
 	stmtSelectAllParks, _ := db.Prepare(selectAllParks)
 	Statements["selectAllParks"] = stmtSelectAllParks
 
+	stmtSelectAllFranchiseEras, _ := db.Prepare(selectAllFranchiseEras)
+	Statements["selectAllFranchiseEras"] = stmtSelectAllFranchiseEras
+
+	stmtInsertFranchiseEra, _ := db.Prepare(insertFranchiseEra)
+	Statements["insertFranchiseEra"] = stmtInsertFranchiseEra
+
+	stmtSelectParkTotals, _ := db.Prepare(selectParkTotals)
+	Statements["selectParkTotals"] = stmtSelectParkTotals
+
+	stmtSelectParkSeasons, _ := db.Prepare(selectParkSeasons)
+	Statements["selectParkSeasons"] = stmtSelectParkSeasons
+
+	stmtSelectParkHomeTeams, _ := db.Prepare(selectParkHomeTeams)
+	Statements["selectParkHomeTeams"] = stmtSelectParkHomeTeams
+
+	stmtSelectParkFactorSplits, _ := db.Prepare(selectParkFactorSplits)
+	Statements["selectParkFactorSplits"] = stmtSelectParkFactorSplits
+
+	for grouping, expression := range attendanceGroupings {
+		stmtSelectAttendance, _ := db.Prepare(fmt.Sprintf(selectAttendance, expression))
+		Statements["selectAttendanceBy_"+grouping] = stmtSelectAttendance
+	}
+
+	stmtSelectMatchupGames, _ := db.Prepare(selectMatchupGames)
+	Statements["selectMatchupGames"] = stmtSelectMatchupGames
+
+	stmtSelectTeamSeasonGames, _ := db.Prepare(selectTeamSeasonGames)
+	Statements["selectTeamSeasonGames"] = stmtSelectTeamSeasonGames
+
+	stmtSelectTeamSeasonTotals, _ := db.Prepare(selectTeamSeasonTotals)
+	Statements["selectTeamSeasonTotals"] = stmtSelectTeamSeasonTotals
+
+	stmtSelectGamesBySeasonAndTeam, _ := db.Prepare(selectGamesBySeasonAndTeam)
+	Statements["selectGamesBySeasonAndTeam"] = stmtSelectGamesBySeasonAndTeam
+
+	stmtSelectMainHomePark, _ := db.Prepare(selectMainHomePark)
+	Statements["selectMainHomePark"] = stmtSelectMainHomePark
+
+	stmtSelectSeasonSummary, _ := db.Prepare(selectSeasonSummary)
+	Statements["selectSeasonSummary"] = stmtSelectSeasonSummary
+
+	stmtSelectSeasonAcquisition, _ := db.Prepare(selectSeasonAcquisition)
+	Statements["selectSeasonAcquisition"] = stmtSelectSeasonAcquisition
+
+	stmtSelectAllGameScores, _ := db.Prepare(selectAllGameScores)
+	Statements["selectAllGameScores"] = stmtSelectAllGameScores
+
+	stmtDeleteTeamRatings, _ := db.Prepare(deleteTeamRatings)
+	Statements["deleteTeamRatings"] = stmtDeleteTeamRatings
+
+	stmtInsertTeamRating, _ := db.Prepare(insertTeamRating)
+	Statements["insertTeamRating"] = stmtInsertTeamRating
+
+	stmtSelectRatingsAsOf, _ := db.Prepare(selectRatingsAsOf)
+	Statements["selectRatingsAsOf"] = stmtSelectRatingsAsOf
+
+	stmtSelectGameRatings, _ := db.Prepare(selectGameRatings)
+	Statements["selectGameRatings"] = stmtSelectGameRatings
+
+	stmtSelectRemainingSchedule, _ := db.Prepare(selectRemainingSchedule)
+	Statements["selectRemainingSchedule"] = stmtSelectRemainingSchedule
+
+	stmtSelectScheduleTeams, _ := db.Prepare(selectScheduleTeams)
+	Statements["selectScheduleTeams"] = stmtSelectScheduleTeams
+
+	stmtSelectThrowsByPersonIDs, _ := db.Prepare(selectThrowsByPersonIDs)
+	Statements["selectThrowsByPersonIDs"] = stmtSelectThrowsByPersonIDs
+
+	stmtSelectTeamStarts, _ := db.Prepare(selectTeamStarts)
+	Statements["selectTeamStarts"] = stmtSelectTeamStarts
+
+	stmtSelectPreviousStart, _ := db.Prepare(selectPreviousStart)
+	Statements["selectPreviousStart"] = stmtSelectPreviousStart
+
 	stmtInsertTeam, _ := db.Prepare(insertTeam)
 	Statements["insertTeam"] = stmtInsertTeam
 
@@ -32,6 +318,51 @@ func prepareQueries(db *sql.DB) {
 
 	stmtInsertPerson, _ := db.Prepare(queryInsertPerson)
 	Statements["insertPerson"] = stmtInsertPerson
+
+	stmtInsertScheduledGame, _ := db.Prepare(insertScheduledGame)
+	Statements["insertScheduledGame"] = stmtInsertScheduledGame
+
+	stmtSelectScheduleByDate, _ := db.Prepare(selectScheduleByDate)
+	Statements["selectScheduleByDate"] = stmtSelectScheduleByDate
+
+	stmtSelectScheduleByMakeupDate, _ := db.Prepare(selectScheduleByMakeupDate)
+	Statements["selectScheduleByMakeupDate"] = stmtSelectScheduleByMakeupDate
+
+	stmtSelectScheduleByPlayDate, _ := db.Prepare(selectScheduleByPlayDate)
+	Statements["selectScheduleByPlayDate"] = stmtSelectScheduleByPlayDate
+
+	stmtSelectGameResults, _ := db.Prepare(selectGameResults)
+	Statements["selectGameResults"] = stmtSelectGameResults
+
+	stmtInsertEjection, _ := db.Prepare(insertEjection)
+	Statements["insertEjection"] = stmtInsertEjection
+
+	stmtSelectEjectionsByGame, _ := db.Prepare(selectEjectionsByGame)
+	Statements["selectEjectionsByGame"] = stmtSelectEjectionsByGame
+
+	stmtInsertTransaction, _ := db.Prepare(insertTransaction)
+	Statements["insertTransaction"] = stmtInsertTransaction
+
+	stmtSelectTransactionsByPerson, _ := db.Prepare(selectTransactionsByPerson)
+	Statements["selectTransactionsByPerson"] = stmtSelectTransactionsByPerson
+
+	stmtSelectPersonByID, _ := db.Prepare(selectPersonByID)
+	Statements["selectPersonByID"] = stmtSelectPersonByID
+
+	stmtUpsertRegisterPerson, _ := db.Prepare(upsertRegisterPerson)
+	Statements["upsertRegisterPerson"] = stmtUpsertRegisterPerson
+
+	stmtUpsertIDMapping, _ := db.Prepare(upsertIDMapping)
+	Statements["upsertIDMapping"] = stmtUpsertIDMapping
+
+	stmtSelectPersonIDBySourceID, _ := db.Prepare(selectPersonIDBySourceID)
+	Statements["selectPersonIDBySourceID"] = stmtSelectPersonIDBySourceID
+
+	stmtSelectSourceIDsByPersonIDs, _ := db.Prepare(selectSourceIDsByPersonIDs)
+	Statements["selectSourceIDsByPersonIDs"] = stmtSelectSourceIDsByPersonIDs
+
+	stmtSelectIDMappingsByPerson, _ := db.Prepare(selectIDMappingsByPerson)
+	Statements["selectIDMappingsByPerson"] = stmtSelectIDMappingsByPerson
 }
 
 const queryInsertGame = `INSERT INTO game (
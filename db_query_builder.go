@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Composes a select where every value is bound to a placeholder.
+// Conditions and ordering are SQL fragments and must never come from user input.
+type QueryBuilder struct {
+	table      string
+	columns    []string
+	conditions []string
+	args       []interface{}
+	groupBy    []string
+	orderBy    []string
+	limit      int
+}
+
+func newQueryBuilder(table string) *QueryBuilder {
+	return &QueryBuilder{table: table}
+}
+
+// Columns to select, all of them when none are given
+func (builder *QueryBuilder) Select(columns ...string) *QueryBuilder {
+	builder.columns = append(builder.columns, columns...)
+
+	return builder
+}
+
+// Adds a condition, each ? in it is replaced with a placeholder bound to the next value
+func (builder *QueryBuilder) Where(condition string, values ...interface{}) *QueryBuilder {
+	var sb strings.Builder
+
+	next := 0
+
+	for _, c := range condition {
+		if c != '?' {
+			sb.WriteRune(c)
+			continue
+		}
+
+		if next >= len(values) {
+			panic(fmt.Sprintf("Missing value for a placeholder in %s", condition))
+		}
+
+		builder.args = append(builder.args, values[next])
+		sb.WriteString(fmt.Sprintf("$%d", len(builder.args)))
+		next++
+	}
+
+	if next != len(values) {
+		panic(fmt.Sprintf("Too many values for %s", condition))
+	}
+
+	builder.conditions = append(builder.conditions, "("+sb.String()+")")
+
+	return builder
+}
+
+func (builder *QueryBuilder) GroupBy(expressions ...string) *QueryBuilder {
+	builder.groupBy = append(builder.groupBy, expressions...)
+
+	return builder
+}
+
+func (builder *QueryBuilder) OrderBy(expressions ...string) *QueryBuilder {
+	builder.orderBy = append(builder.orderBy, expressions...)
+
+	return builder
+}
+
+func (builder *QueryBuilder) Limit(limit int) *QueryBuilder {
+	builder.limit = limit
+
+	return builder
+}
+
+func (builder *QueryBuilder) Build() (string, []interface{}) {
+	columns := "*"
+
+	if len(builder.columns) > 0 {
+		columns = strings.Join(builder.columns, ", ")
+	}
+
+	query := "select " + columns + " from " + builder.table
+
+	if len(builder.conditions) > 0 {
+		query += " where " + strings.Join(builder.conditions, " and ")
+	}
+
+	if len(builder.groupBy) > 0 {
+		query += " group by " + strings.Join(builder.groupBy, ", ")
+	}
+
+	if len(builder.orderBy) > 0 {
+		query += " order by " + strings.Join(builder.orderBy, ", ")
+	}
+
+	if builder.limit > 0 {
+		query += fmt.Sprintf(" limit %d", builder.limit)
+	}
+
+	return query, builder.args
+}
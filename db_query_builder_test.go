@@ -0,0 +1,78 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestQueryBuilder(t *testing.T) {
+	query, args := newQueryBuilder("game").
+		Where("visiting_team = ? or home_team = ?", "BOS", "BOS").
+		Where("attendance >= ?", 30000).
+		OrderBy("game_date desc").
+		Limit(10).
+		Build()
+
+	assertEqual(t, query, "select * from game where (visiting_team = $1 or home_team = $2) and (attendance >= $3) order by game_date desc limit 10")
+	assertEqual(t, len(args), 3)
+	assertEqual(t, args[2], 30000)
+}
+
+func TestBuildGameSearchQuery(t *testing.T) {
+	search := GameSearch{
+		Park:       "BOS07",
+		MinRuns:    -1,
+		Sort:       "attendance",
+		Descending: true,
+		Limit:      25,
+		Cursor:     &GameCursor{SortValue: "37000", Date: "2018-04-10", NumberOfGame: "0", HomeTeam: "BOS"},
+	}
+
+	query, args := buildGameSearchQuery(&search)
+
+	assertEqual(t, query, "select * from game where (park_id = $1) and ((attendance, game_date, number_of_game, home_team) < ($2::int, $3::date, $4, $5))"+
+		" order by attendance desc, game_date desc, number_of_game desc, home_team desc limit 26")
+	assertEqual(t, len(args), 5)
+
+	cursor, ok := decodeGameCursor(encodeGameCursor(search.Cursor))
+
+	assertEqual(t, ok, true)
+	assertEqual(t, *cursor, *search.Cursor)
+}
+
+func TestBuildPaceQuery(t *testing.T) {
+	filter := PaceFilter{GroupBy: "team", Season: 2018, Park: "BOS07"}
+
+	query, args := buildPaceQuery(&filter)
+
+	assertEqual(t, strings.HasPrefix(query, "select pace_team.team as key, count(*)"), true)
+	assertEqual(t, strings.HasSuffix(query, "from game cross join lateral unnest(array[visiting_team, home_team]) as pace_team(team)"+
+		" where (time_of_game_in_mins > 0 and game_length_in_outs > 0) and (extract(year from game_date)::int = $1) and (park_id = $2)"+
+		" group by key order by key"), true)
+	assertEqual(t, len(args), 2)
+
+	query, _ = buildPaceDistributionQuery(&filter)
+
+	assertEqual(t, strings.Contains(query, "time_of_game_in_mins / 15 * 15 as bucket"), true)
+
+	filter = PaceFilter{GroupBy: "team", Team: "BOS"}
+
+	query, args = buildPaceQuery(&filter)
+
+	assertEqual(t, strings.Contains(query, " and (pace_team.team = $3) group by key"), true)
+	assertEqual(t, len(args), 3)
+
+	filter = PaceFilter{GroupBy: "pitchers_used"}
+
+	query, _ = buildPaceQuery(&filter)
+
+	assertEqual(t, strings.HasSuffix(query, " group by key, "+pacePitchersUsed+" order by "+pacePitchersUsed), true)
+}
+
+func TestBuildLineScoresQuery(t *testing.T) {
+	query, args := buildLineScoresQuery(2010, 2018, "BOS", "")
+
+	assertEqual(t, strings.Contains(query, " and (extract(year from game_date)::int between $1 and $2)"+
+		" and (visiting_team = $3 or home_team = $4)"), true)
+	assertEqual(t, len(args), 4)
+}
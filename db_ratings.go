@@ -0,0 +1,60 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+type CurrentTeamRating struct {
+	Team     string
+	LastGame time.Time
+	Rating   float64
+}
+
+// Latest rating of every team that played in the year before the date (YYYY-MM-DD)
+func loadRatingsAsOf(date string) ([]CurrentTeamRating, error) {
+	stmt := Statements["selectRatingsAsOf"]
+
+	rows, err := stmt.Query(date)
+
+	ratings := []CurrentTeamRating{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return ratings, err
+	}
+
+	for rows.Next() {
+		var rating CurrentTeamRating
+
+		rows.Scan(&rating.Team, &rating.LastGame, &rating.Rating)
+
+		ratings = append(ratings, rating)
+	}
+
+	return ratings, nil
+}
+
+// Pre-game ratings of both teams keyed by team, empty when ratings were not computed
+func loadGameRatings(game *Game) (map[string]TeamRating, error) {
+	stmt := Statements["selectGameRatings"]
+
+	rows, err := stmt.Query(game.Date, game.NumberOfGame, game.VisitingTeam, game.HomeTeam)
+
+	ratings := make(map[string]TeamRating)
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return ratings, err
+	}
+
+	for rows.Next() {
+		var rating TeamRating
+
+		rows.Scan(&rating.Team, &rating.RatingBefore, &rating.WinProbability)
+
+		ratings[rating.Team] = rating
+	}
+
+	return ratings, nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"database/sql"
+	"log"
+	"time"
+)
+
+// The team's starting pitchers in the season, in chronological order, with the
+// date of each pitcher's previous start in the season for any team
+func loadTeamStarts(team string, season int) ([]TeamStart, error) {
+	stmt := Statements["selectTeamStarts"]
+
+	rows, err := stmt.Query(team, season)
+
+	starts := []TeamStart{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return starts, err
+	}
+
+	for rows.Next() {
+		var start TeamStart
+
+		rows.Scan(
+			&start.Date,
+			&start.NumberOfGame,
+			&start.GameNumber,
+			&start.Pitcher.ID,
+			&start.Pitcher.Name,
+			&start.PreviousStart,
+		)
+
+		starts = append(starts, start)
+	}
+
+	return starts, nil
+}
+
+// Days of rest before the pitcher's start, nil for the first start of the season
+func loadDaysRest(pitcherID string, date time.Time, numberOfGame string) (*int, error) {
+	if pitcherID == "" {
+		return nil, nil
+	}
+
+	stmt := Statements["selectPreviousStart"]
+
+	var previous time.Time
+
+	err := stmt.QueryRow(pitcherID, date, numberOfGame).Scan(&previous)
+
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return nil, err
+	}
+
+	days := getDaysRest(previous, date)
+
+	return &days, nil
+}
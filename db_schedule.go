@@ -0,0 +1,150 @@
+package main
+
+import (
+	"log"
+	"time"
+)
+
+type GameResult struct {
+	Date              time.Time
+	NumberOfGame      string
+	VisitingTeamScore int
+	HomeTeamScore     int
+}
+
+func loadScheduledGamesWith(statement string, args ...interface{}) ([]RawScheduledGame, error) {
+	stmt := Statements[statement]
+
+	rows, err := stmt.Query(args...)
+
+	games := []RawScheduledGame{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return games, err
+	}
+
+	for rows.Next() {
+		var game RawScheduledGame
+
+		rows.Scan(
+			&game.Date,
+			&game.NumberOfGame,
+			&game.DayOfWeek,
+			&game.VisitingTeam,
+			&game.VisitingTeamLeague,
+			&game.VisitingGameNumber,
+			&game.HomeTeam,
+			&game.HomeTeamLeague,
+			&game.HomeTeamGameNumber,
+			&game.DayNightIndicator,
+			&game.PostponementInformation,
+			&game.MakeupInformation,
+			&game.MakeupDate,
+		)
+
+		games = append(games, game)
+	}
+
+	return games, nil
+}
+
+// Games that were scheduled for the date
+func loadScheduledGames(date string) ([]RawScheduledGame, error) {
+	return loadScheduledGamesWith("selectScheduleByDate", date)
+}
+
+// Postponed games that were made up on the date
+func loadMadeUpGames(date string) ([]RawScheduledGame, error) {
+	return loadScheduledGamesWith("selectScheduleByMakeupDate", date)
+}
+
+// Games of the matchup that were played on the date, as scheduled or as makeups
+func loadScheduledMatchupGames(date time.Time, visitingTeam string, homeTeam string) ([]RawScheduledGame, error) {
+	return loadScheduledGamesWith("selectScheduleByPlayDate", date, visitingTeam, homeTeam)
+}
+
+func loadGameResults(date time.Time, visitingTeam string, homeTeam string) ([]GameResult, error) {
+	stmt := Statements["selectGameResults"]
+
+	rows, err := stmt.Query(visitingTeam, homeTeam, date)
+
+	results := []GameResult{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return results, err
+	}
+
+	for rows.Next() {
+		var result GameResult
+
+		rows.Scan(
+			&result.Date,
+			&result.NumberOfGame,
+			&result.VisitingTeamScore,
+			&result.HomeTeamScore,
+		)
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+type RemainingGame struct {
+	VisitingTeam string
+	HomeTeam     string
+	Date         time.Time
+}
+
+func loadRemainingSchedule(season int, date string) ([]RemainingGame, error) {
+	stmt := Statements["selectRemainingSchedule"]
+
+	rows, err := stmt.Query(season, date)
+
+	games := []RemainingGame{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return games, err
+	}
+
+	for rows.Next() {
+		var game RemainingGame
+
+		rows.Scan(
+			&game.VisitingTeam,
+			&game.HomeTeam,
+			&game.Date,
+		)
+
+		games = append(games, game)
+	}
+
+	return games, nil
+}
+
+// Teams and their leagues on the season's schedule
+func loadScheduleTeams(season int) ([]TeamSeasonTotals, error) {
+	stmt := Statements["selectScheduleTeams"]
+
+	rows, err := stmt.Query(season)
+
+	teams := []TeamSeasonTotals{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return teams, err
+	}
+
+	for rows.Next() {
+		var team TeamSeasonTotals
+
+		rows.Scan(&team.Team, &team.League)
+
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
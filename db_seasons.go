@@ -0,0 +1,66 @@
+package main
+
+import (
+	"database/sql"
+	"log"
+
+	"github.com/lib/pq"
+)
+
+type SeasonTotals struct {
+	Games             int
+	OpeningDate       pq.NullTime
+	ClosingDate       pq.NullTime
+	Attendance        int64
+	AverageTimeOfGame sql.NullFloat64
+	ExtraInningsGames int
+}
+
+type SeasonAcquisition struct {
+	Code  string
+	Games int
+}
+
+func loadSeasonTotals(season int) (SeasonTotals, error) {
+	stmt := Statements["selectSeasonSummary"]
+
+	var totals SeasonTotals
+
+	err := stmt.QueryRow(season).Scan(
+		&totals.Games,
+		&totals.OpeningDate,
+		&totals.ClosingDate,
+		&totals.Attendance,
+		&totals.AverageTimeOfGame,
+		&totals.ExtraInningsGames,
+	)
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+	}
+
+	return totals, err
+}
+
+func loadSeasonAcquisition(season int) ([]SeasonAcquisition, error) {
+	stmt := Statements["selectSeasonAcquisition"]
+
+	rows, err := stmt.Query(season)
+
+	acquisition := []SeasonAcquisition{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return acquisition, err
+	}
+
+	for rows.Next() {
+		var row SeasonAcquisition
+
+		rows.Scan(&row.Code, &row.Games)
+
+		acquisition = append(acquisition, row)
+	}
+
+	return acquisition, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"log"
+)
+
+type TeamSeasonTotals struct {
+	Team   string
+	League string
+	Games  int
+	Wins   int
+	Losses int
+	// Batting of the team and of its opponents, runs are in Runs
+	Batting         Batting
+	OpponentBatting Batting
+}
+
+func (totals *TeamSeasonTotals) Ties() int {
+	return totals.Games - totals.Wins - totals.Losses
+}
+
+// Totals of every team in the season, counting games played on or before asOf (YYYY-MM-DD)
+func loadTeamSeasonTotals(season int, asOf string) ([]TeamSeasonTotals, error) {
+	stmt := Statements["selectTeamSeasonTotals"]
+
+	rows, err := stmt.Query(season, asOf)
+
+	teams := []TeamSeasonTotals{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return teams, err
+	}
+
+	for rows.Next() {
+		var totals TeamSeasonTotals
+
+		batting := &totals.Batting
+		opponent := &totals.OpponentBatting
+
+		rows.Scan(
+			&totals.Team,
+			&totals.League,
+			&totals.Games,
+			&totals.Wins,
+			&totals.Losses,
+			&batting.Runs,
+			&opponent.Runs,
+			&batting.AtBats,
+			&batting.Hits,
+			&batting.Doubles,
+			&batting.Triples,
+			&batting.HomeRuns,
+			&batting.SacrificeHits,
+			&batting.SacrificeFlies,
+			&batting.HitByPitch,
+			&batting.Walks,
+			&batting.IntentionalWalks,
+			&batting.Strikeouts,
+			&batting.StolenBases,
+			&batting.CaughtStealing,
+			&batting.GroundedIntoDoublePlay,
+			&opponent.AtBats,
+			&opponent.Hits,
+			&opponent.Doubles,
+			&opponent.Triples,
+			&opponent.HomeRuns,
+			&opponent.SacrificeHits,
+			&opponent.SacrificeFlies,
+			&opponent.HitByPitch,
+			&opponent.Walks,
+			&opponent.IntentionalWalks,
+			&opponent.Strikeouts,
+			&opponent.StolenBases,
+			&opponent.CaughtStealing,
+			&opponent.GroundedIntoDoublePlay,
+		)
+
+		teams = append(teams, totals)
+	}
+
+	return teams, nil
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Per-game statistics of one side in the game table, batting ones come first
+var teamGameBattingColumns = []string{
+	"ab", "h", "2b", "3b", "hr", "rbi", "sh", "sf", "hbp", "bb", "ibb", "k", "sb", "cs", "gidp", "ci", "lob",
+}
+var teamGamePitchingAndFieldingColumns = []string{
+	"pitchers_used", "individual_earned_runs", "team_earned_runs", "wild_pitches", "balks",
+	"putouts", "assists", "errors", "passed_balls", "double_plays", "triple_plays",
+}
+
+// One row per team per game, opponent_ columns hold the batting of the other team.
+// Missing statistics are stored as -1 and count as 0.
+func getTeamGameSide(side string, team string, opponent string) string {
+	columns := []string{
+		"extract(year from game_date)::int as season",
+		"game_date",
+		fmt.Sprintf("'%s' as side", side),
+		fmt.Sprintf("%s_team as team", team),
+		fmt.Sprintf("%s_team_league as league", team),
+		fmt.Sprintf("%s_team as opponent", opponent),
+		"day_night_indicator as day_night",
+		fmt.Sprintf("%s_team_score as runs", team),
+		fmt.Sprintf("%s_team_score as runs_allowed", opponent),
+	}
+
+	for _, column := range append(teamGameBattingColumns, teamGamePitchingAndFieldingColumns...) {
+		columns = append(columns, fmt.Sprintf("greatest(%s_%s, 0) as stat_%s", team, column, column))
+	}
+
+	for _, column := range teamGameBattingColumns {
+		columns = append(columns, fmt.Sprintf("greatest(%s_%s, 0) as opponent_%s", opponent, column, column))
+	}
+
+	return "select " + strings.Join(columns, ", ") + " from game"
+}
+
+var teamGameTable = "(" + getTeamGameSide("away", "visiting", "home") +
+	" union all " + getTeamGameSide("home", "home", "visiting") + ") as team_game"
+
+func getTeamStatsColumns() []string {
+	columns := []string{
+		"team",
+		"max(league)",
+		"count(*)",
+		"count(*) filter (where runs > runs_allowed)",
+		"count(*) filter (where runs < runs_allowed)",
+		"sum(runs)",
+		"sum(runs_allowed)",
+	}
+
+	for _, column := range append(teamGameBattingColumns, teamGamePitchingAndFieldingColumns...) {
+		columns = append(columns, "sum(stat_"+column+")")
+	}
+
+	for _, column := range teamGameBattingColumns {
+		columns = append(columns, "sum(opponent_"+column+")")
+	}
+
+	return columns
+}
+
+type TeamStatsFilter struct {
+	Season int
+	// Empty values do not filter
+	Team     string
+	League   string
+	Side     string
+	DayNight string
+	Opponent string
+}
+
+type TeamStats struct {
+	Team            string
+	League          string
+	Games           int
+	Wins            int
+	Losses          int
+	Batting         Batting
+	Pitching        Pitching
+	Fielding        Fielding
+	OpponentBatting Batting
+}
+
+func buildTeamStatsQuery(filter *TeamStatsFilter) (string, []interface{}) {
+	builder := newQueryBuilder(teamGameTable).
+		Select(getTeamStatsColumns()...).
+		Where("season = ?", filter.Season)
+
+	if filter.Team != "" {
+		builder.Where("team = ?", filter.Team)
+	}
+
+	if filter.League != "" {
+		builder.Where("league = ?", filter.League)
+	}
+
+	if filter.Side != "" {
+		builder.Where("side = ?", filter.Side)
+	}
+
+	if filter.DayNight != "" {
+		builder.Where("day_night = ?", filter.DayNight)
+	}
+
+	if filter.Opponent != "" {
+		builder.Where("opponent = ?", filter.Opponent)
+	}
+
+	return builder.GroupBy("team").OrderBy("team").Build()
+}
+
+func scanBatting(batting *Batting) []interface{} {
+	return []interface{}{
+		&batting.AtBats,
+		&batting.Hits,
+		&batting.Doubles,
+		&batting.Triples,
+		&batting.HomeRuns,
+		&batting.RunsBattedIn,
+		&batting.SacrificeHits,
+		&batting.SacrificeFlies,
+		&batting.HitByPitch,
+		&batting.Walks,
+		&batting.IntentionalWalks,
+		&batting.Strikeouts,
+		&batting.StolenBases,
+		&batting.CaughtStealing,
+		&batting.GroundedIntoDoublePlay,
+		&batting.CatcherInterference,
+		&batting.LeftOnBase,
+	}
+}
+
+func loadTeamStats(filter *TeamStatsFilter) ([]TeamStats, error) {
+	query, args := buildTeamStatsQuery(filter)
+
+	rows, err := db.Query(query, args...)
+
+	teams := []TeamStats{}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return teams, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var stats TeamStats
+
+		pitching := &stats.Pitching
+		fielding := &stats.Fielding
+
+		fields := []interface{}{
+			&stats.Team,
+			&stats.League,
+			&stats.Games,
+			&stats.Wins,
+			&stats.Losses,
+			&stats.Batting.Runs,
+			&stats.OpponentBatting.Runs,
+		}
+
+		fields = append(fields, scanBatting(&stats.Batting)...)
+		fields = append(fields,
+			&pitching.PitchersUsed,
+			&pitching.IndividualEarnedRuns,
+			&pitching.TeamEarnedRuns,
+			&pitching.WildPitches,
+			&pitching.Balks,
+			&fielding.Putouts,
+			&fielding.Assists,
+			&fielding.Errors,
+			&fielding.PassedBalls,
+			&fielding.DoublePlays,
+			&fielding.TriplePlays,
+		)
+		fields = append(fields, scanBatting(&stats.OpponentBatting)...)
+
+		rows.Scan(fields...)
+
+		teams = append(teams, stats)
+	}
+
+	return teams, nil
+}
+
+// Park where the team played most of its home games in the season, empty if it had none
+func loadMainHomePark(team string, season int) (string, error) {
+	stmt := Statements["selectMainHomePark"]
+
+	var parkID string
+
+	err := stmt.QueryRow(team, season).Scan(&parkID)
+
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+
+	if err != nil {
+		log.Printf("ERROR %s", err)
+		return "", err
+	}
+
+	return parkID, nil
+}
@@ -9,3 +9,99 @@ GET http://localhost:8000/api/v1/games/2018-03-29/BOS@TBA/stats
 
 GET http://localhost:8000/api/v1/teams/TBA
 ###
+
+GET http://localhost:8000/api/v1/schedule?date=2018-04-15
+###
+
+GET http://localhost:8000/api/v1/games/2018-04-15/KCA@LAA/ejections
+###
+
+GET http://localhost:8000/api/v1/people/bettm001/transactions
+###
+
+GET http://localhost:8000/api/v1/people/bettm001
+###
+
+GET http://localhost:8000/api/v1/people/lookup?source=mlbam&id=605141
+###
+
+GET http://localhost:8000/api/v1/games/2018-03-29/BOS@TBA/lineups?id_scheme=mlbam
+###
+
+GET http://localhost:8000/api/v1/teams/LAN
+###
+
+GET http://localhost:8000/api/v1/parks?date=2018-03-29
+###
+
+GET http://localhost:8000/api/v1/parks/STP01
+###
+
+GET http://localhost:8000/api/v1/parks/STP01/factors?years=3
+###
+
+GET http://localhost:8000/api/v1/attendance?group_by=team&season=2018
+###
+
+GET http://localhost:8000/api/v1/attendance?group_by=date&team=BOS&season=2018
+###
+
+GET http://localhost:8000/api/v1/matchups/BOS-NYA?from=2018-01-01&to=2018-12-31
+###
+
+GET http://localhost:8000/api/v1/teams/BOS/series?season=2018
+###
+
+GET http://localhost:8000/api/v1/teams/BOS/timeline?season=2018
+###
+
+GET http://localhost:8000/api/v1/standings?date=2018-07-01
+###
+
+GET http://localhost:8000/api/v1/teams/BOS/expected?season=2018
+###
+
+GET http://localhost:8000/api/v1/games/search?notable=walk_off&season=2018&team=BOS
+###
+
+GET http://localhost:8000/api/v1/games?team=BOS&from=2018-04-01&to=2018-04-30&sort=-attendance&limit=10
+###
+
+GET http://localhost:8000/api/v1/teams/BOS/stats?season=2018&split=vs=NYA
+###
+
+GET http://localhost:8000/api/v1/leaders/teams?stat=home_runs&season=2018&league=NL
+###
+
+GET http://localhost:8000/api/v1/leagues/AL/seasons/2018
+###
+
+GET http://localhost:8000/api/v1/teams/BOS/stats?season=2018&plus=true
+###
+
+GET http://localhost:8000/api/v1/seasons/2018
+###
+
+GET http://localhost:8000/api/v1/analytics/pace?group_by=season
+###
+
+GET http://localhost:8000/api/v1/analytics/pace?group_by=umpire_crew&season=2018
+###
+
+GET http://localhost:8000/api/v1/ratings?date=2018-07-01
+###
+
+GET http://localhost:8000/api/v1/projections?date=2018-08-01&seed=1
+###
+
+GET http://localhost:8000/api/v1/analytics/innings?group_by=team&season=2018
+###
+
+GET http://localhost:8000/api/v1/teams/BOS/lineups?season=2018
+###
+
+GET http://localhost:8000/api/v1/teams/BOS/rotation?season=2018
+###
+
+GET http://localhost:8000/api/v1/analytics/innings?group_by=season&from=2010&to=2018
+###
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"log"
+	"os"
+	"time"
+)
+
+type RawEjection struct {
+	GameID       string
+	Date         time.Time
+	HomeTeam     string
+	NumberOfGame string
+	PersonID     string
+	PersonName   string
+	Team         string
+	Job          string
+	UmpireID     string
+	UmpireName   string
+	Inning       int
+	Reason       string
+}
+
+// Game IDs are the home team symbol followed by yyyymmdd and the game number, e.g. BOS201804150
+func parseGameID(gameID string) (string, time.Time, string) {
+	if len(gameID) != 12 {
+		return "", time.Unix(0, 0), ""
+	}
+
+	return gameID[:3], parseRetrosheetDate(gameID[3:11]), gameID[11:]
+}
+
+func readRawEjection(line []string) *RawEjection {
+	homeTeam, date, numberOfGame := parseGameID(line[0])
+
+	return &RawEjection{
+		GameID:       line[0],
+		Date:         date,
+		HomeTeam:     homeTeam,
+		NumberOfGame: numberOfGame,
+		PersonID:     line[3],
+		PersonName:   line[4],
+		Team:         line[5],
+		Job:          line[6],
+		UmpireID:     line[7],
+		UmpireName:   line[8],
+		Inning:       parseInt(line[9]),
+		Reason:       line[10],
+	}
+}
+
+func loadEjections(path string) {
+	csvFile, err := os.Open(path)
+
+	if err != nil {
+		panic(err)
+	}
+
+	reader := csv.NewReader(bufio.NewReader(csvFile))
+
+	var ejections []*RawEjection
+
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Fatal(err)
+		}
+
+		// Skip the header
+		if line[0] == "GAMEID" {
+			continue
+		}
+
+		ejections = append(ejections, readRawEjection(line))
+	}
+
+	stmt := Statements["insertEjection"]
+
+	log.Println("Inserting ejections")
+
+	for _, ejection := range ejections {
+		_, err := stmt.Exec(
+			ejection.GameID,
+			ejection.Date,
+			ejection.HomeTeam,
+			ejection.NumberOfGame,
+			ejection.PersonID,
+			ejection.PersonName,
+			ejection.Team,
+			ejection.Job,
+			ejection.UmpireID,
+			ejection.UmpireName,
+			ejection.Inning,
+			ejection.Reason,
+		)
+
+		if err != nil {
+			log.Printf("Error when inserting ejection %v %s", ejection, err)
+		}
+	}
+
+	log.Printf("Inserted ejections")
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Name, location and league of a team symbol between two dates.
+// EndDate is null for the era that is still current.
+type RawFranchiseEra struct {
+	FranchiseID   string
+	TeamSymbol    string
+	League        string
+	Division      string
+	Location      string
+	Name          string
+	AlternateName string
+	StartDate     time.Time
+	EndDate       pq.NullTime
+	City          string
+	State         string
+}
+
+func (era *RawFranchiseEra) Contains(date time.Time) bool {
+	if date.Before(era.StartDate) {
+		return false
+	}
+
+	return !era.EndDate.Valid || !date.After(era.EndDate.Time)
+}
+
+// Reads a line of Retrosheet's CurrentNames.csv
+func readRawFranchiseEra(line []string) *RawFranchiseEra {
+	startDate := parseNullDate("1/2/2006", line[7])
+
+	return &RawFranchiseEra{
+		FranchiseID:   line[0],
+		TeamSymbol:    line[1],
+		League:        line[2],
+		Division:      line[3],
+		Location:      line[4],
+		Name:          line[5],
+		AlternateName: line[6],
+		StartDate:     startDate.Time,
+		EndDate:       parseNullDate("1/2/2006", line[8]),
+		City:          line[9],
+		State:         line[10],
+	}
+}
+
+func loadFranchises(path string) {
+	csvFile, err := os.Open(path)
+
+	if err != nil {
+		panic(err)
+	}
+
+	reader := csv.NewReader(bufio.NewReader(csvFile))
+
+	var eras []*RawFranchiseEra
+
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Fatal(err)
+		}
+
+		eras = append(eras, readRawFranchiseEra(line))
+	}
+
+	stmt := Statements["insertFranchiseEra"]
+
+	log.Println("Inserting franchises")
+
+	for _, era := range eras {
+		_, err := stmt.Exec(
+			era.FranchiseID,
+			era.TeamSymbol,
+			era.League,
+			era.Division,
+			era.Location,
+			era.Name,
+			era.AlternateName,
+			era.StartDate,
+			era.EndDate,
+			era.City,
+			era.State,
+		)
+
+		if err != nil {
+			log.Printf("Error when inserting franchise era %v %s", era, err)
+		}
+	}
+
+	log.Printf("Inserted franchises")
+}
@@ -1,18 +1,22 @@
 package main
 
 import (
+	"archive/zip"
 	"bufio"
 	"encoding/csv"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type Game struct {
@@ -227,9 +231,9 @@ func handleNull(value string) string {
 	return value
 }
 
-// TODO: Move somewhere else
-func parseUSDate(date string) time.Time {
-	layout := "01/02/2006"
+// Dates in Retrosheet game logs and schedules use yyyymmdd
+func parseRetrosheetDate(date string) time.Time {
+	layout := "20060102"
 
 	var parsedDate time.Time
 	parsedDate, err := time.Parse(layout, date)
@@ -241,6 +245,16 @@ func parseUSDate(date string) time.Time {
 	return parsedDate
 }
 
+func parseNullDate(layout string, date string) pq.NullTime {
+	parsedDate, err := time.Parse(layout, date)
+
+	if err != nil {
+		return pq.NullTime{}
+	}
+
+	return pq.NullTime{Time: parsedDate, Valid: true}
+}
+
 func readLine(line []string) *Game {
 	visitingGameNumber := parseInt(line[5])
 	homeGameNumber := parseInt(line[8])
@@ -253,16 +267,8 @@ func readLine(line []string) *Game {
 	attendance := parseInt(line[17])
 	timeOfGameInMins := parseInt(line[18])
 
-	layout := "20060102"
-	var parsedDate time.Time
-	parsedDate, err := time.Parse(layout, line[0])
-
-	if err != nil {
-		parsedDate = time.Unix(0, 0)
-	}
-
 	return &Game{
-		Date:               parsedDate,
+		Date:               parseRetrosheetDate(line[0]),
 		DateRaw:            line[0],
 		NumberOfGame:       line[1],
 		DayOfWeek:          line[2],
@@ -442,79 +448,213 @@ func readLine(line []string) *Game {
 	}
 }
 
-func getGameLogsFiles(dir string) ([]string, error) {
+type SeasonRange struct {
+	From int
+	To   int
+}
+
+// Accepts a single season (2018) or a range (1990-2018), empty value means all seasons
+func parseSeasonRange(value string) (SeasonRange, error) {
+	if value == "" {
+		return SeasonRange{From: 0, To: math.MaxInt32}, nil
+	}
+
+	bounds := strings.SplitN(value, "-", 2)
+
+	from, err := strconv.Atoi(bounds[0])
+
+	if err != nil {
+		return SeasonRange{}, fmt.Errorf("invalid season range %q", value)
+	}
+
+	to := from
+
+	if len(bounds) == 2 {
+		to, err = strconv.Atoi(bounds[1])
+
+		if err != nil || to < from {
+			return SeasonRange{}, fmt.Errorf("invalid season range %q", value)
+		}
+	}
+
+	return SeasonRange{From: from, To: to}, nil
+}
+
+func (seasons SeasonRange) Contains(season int) bool {
+	return season >= seasons.From && season <= seasons.To
+}
+
+var gameLogSeasonRegexp = regexp.MustCompile(`(?i)^gl(\d{4})\.txt$`)
+
+// Returns the season of a game log file named like GL2018.TXT, or -1 if it doesn't follow that naming
+func gameLogSeason(path string) int {
+	match := gameLogSeasonRegexp.FindStringSubmatch(filepath.Base(path))
+
+	if match == nil {
+		return -1
+	}
+
+	return parseInt(match[1])
+}
+
+func isGameLogFile(path string, seasons SeasonRange) bool {
+	if strings.ToLower(filepath.Ext(path)) != ".txt" {
+		return false
+	}
+
+	season := gameLogSeason(path)
+
+	return season == -1 || seasons.Contains(season)
+}
+
+func isZipFile(path string) bool {
+	return strings.ToLower(filepath.Ext(path)) == ".zip"
+}
+
+// Path can be a single game log, a zip archive or a directory containing either
+func getGameLogsFiles(path string, seasons SeasonRange) ([]string, error) {
 	var gameLogFiles []string
-	files, err := ioutil.ReadDir(dir)
+
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return gameLogFiles, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	files, err := ioutil.ReadDir(path)
 
 	if err != nil {
 		return gameLogFiles, err
 	}
 
 	for _, file := range files {
-		path := filepath.Join(dir, file.Name())
-		ext := strings.ToLower(filepath.Ext(path))
+		filePath := filepath.Join(path, file.Name())
 
-		if ext == ".txt" {
-			gameLogFiles = append(gameLogFiles, path)
+		if isZipFile(filePath) || isGameLogFile(filePath, seasons) {
+			gameLogFiles = append(gameLogFiles, filePath)
 		}
 	}
 
 	return gameLogFiles, nil
 }
 
-func parseGames(gameLogFilePath string) ([]*Game, error) {
+func parseGames(gameLog io.Reader, seasons SeasonRange) ([]*Game, error) {
 	var games []*Game
 
-	csvFile, err := os.Open(gameLogFilePath)
-
-	if err != nil {
-		return games, err
-	}
-
-	reader := csv.NewReader(bufio.NewReader(csvFile))
+	reader := csv.NewReader(bufio.NewReader(gameLog))
 
 	for {
 		line, err := reader.Read()
 		if err == io.EOF {
 			break
 		} else if err != nil {
-			log.Fatal(err)
+			return games, err
 		}
 
-		games = append(games, readLine(line))
+		game := readLine(line)
+
+		if seasons.Contains(game.Date.Year()) {
+			games = append(games, game)
+		}
 	}
 
 	return games, nil
 }
 
-func loadGameLogs(dir string) {
-	db := getDBConnection()
-
-	gameLogFiles, err := getGameLogsFiles(dir)
+// Game logs in zip archives are streamed one entry at a time without extracting them
+func parseGamesFromZip(path string, seasons SeasonRange, process func(source string, games []*Game)) error {
+	archive, err := zip.OpenReader(path)
 
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	for _, path := range gameLogFiles {
-		log.Println("Parsing games from ", path)
-		games, err := parseGames(path)
+	defer archive.Close()
+
+	for _, file := range archive.File {
+		if !isGameLogFile(file.Name, seasons) {
+			continue
+		}
+
+		entry, err := file.Open()
 
 		if err != nil {
-			panic(err)
+			return err
 		}
 
-		log.Println("Inserting games from ", path)
+		source := fmt.Sprintf("%s:%s", path, file.Name)
+		log.Println("Parsing games from ", source)
 
-		for _, game := range games {
-			err := insertGame(game, db)
+		games, err := parseGames(entry, seasons)
+		entry.Close()
 
-			if err != nil {
-				log.Fatalf("Error when inserting game: %v %s", game, err)
-			}
+		if err != nil {
+			return err
 		}
 
-		log.Printf("Processed %d games", len(games))
+		process(source, games)
+	}
+
+	return nil
+}
+
+func parseGamesFromFile(path string, seasons SeasonRange, process func(source string, games []*Game)) error {
+	if isZipFile(path) {
+		return parseGamesFromZip(path, seasons, process)
+	}
+
+	csvFile, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer csvFile.Close()
+
+	log.Println("Parsing games from ", path)
+
+	games, err := parseGames(csvFile, seasons)
+
+	if err != nil {
+		return err
+	}
+
+	process(path, games)
+
+	return nil
+}
+
+func loadGameLogs(path string, seasons SeasonRange) {
+	db := getDBConnection()
+
+	gameLogFiles, err := getGameLogsFiles(path, seasons)
+
+	if err != nil {
+		panic(err)
+	}
+
+	for _, gameLogFile := range gameLogFiles {
+		err := parseGamesFromFile(gameLogFile, seasons, func(source string, games []*Game) {
+			log.Println("Inserting games from ", source)
+
+			for _, game := range games {
+				err := insertGame(game, db)
+
+				if err != nil {
+					log.Fatalf("Error when inserting game: %v %s", game, err)
+				}
+			}
+
+			log.Printf("Processed %d games", len(games))
+		})
+
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	db.Close()
@@ -8,18 +8,27 @@ import (
 	"os"
 	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type RawPark struct {
-	ParkID    string    `json:"park_id"`
-	Name      string    `json:"name"`
-	Nickname  string    `json:"nickname"`
-	City      string    `json:"city"`
-	State     string    `json:"state"`
-	StartDate time.Time `json:"start_date"`
-	EndDate   time.Time `json:"end_date"`
-	League    string    `json:"league"`
+	ParkID    string      `json:"park_id"`
+	Name      string      `json:"name"`
+	Nickname  string      `json:"nickname"`
+	City      string      `json:"city"`
+	State     string      `json:"state"`
+	StartDate pq.NullTime `json:"start_date"`
+	EndDate   pq.NullTime `json:"end_date"`
+	League    string      `json:"league"`
+}
+
+// Parks without an end date are still in use
+func (park *RawPark) InUse(date time.Time) bool {
+	if park.StartDate.Valid && date.Before(park.StartDate.Time) {
+		return false
+	}
+
+	return !park.EndDate.Valid || !date.After(park.EndDate.Time)
 }
 
 func readRawPark(line []string) *RawPark {
@@ -29,8 +38,8 @@ func readRawPark(line []string) *RawPark {
 		Nickname:  line[2],
 		City:      line[3],
 		State:     line[4],
-		StartDate: parseUSDate(line[5]),
-		EndDate:   parseUSDate(line[6]),
+		StartDate: parseNullDate("01/02/2006", line[5]),
+		EndDate:   parseNullDate("01/02/2006", line[6]),
 		League:    line[7],
 	}
 }
@@ -2,23 +2,49 @@ package main
 
 import (
 	"bufio"
+	"database/sql"
 	"encoding/csv"
+	"fmt"
 	"io"
 	"log"
 	"os"
-	"time"
+	"strconv"
+	"strings"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 type RawPerson struct {
-	PersonID     string    `json:"person_id"`
-	LastName     string    `json:"last_name"`
-	FirstName    string    `json:"first_name"`
-	PlayerDebut  time.Time `json:"player_debut"`
-	ManagerDebut time.Time `json:"manager_debut"`
-	CoachDebut   time.Time `json:"coach_debut"`
-	UmpireDebut  time.Time `json:"umpire_debut"`
+	PersonID     string      `json:"person_id"`
+	LastName     string      `json:"last_name"`
+	FirstName    string      `json:"first_name"`
+	PlayerDebut  pq.NullTime `json:"player_debut"`
+	ManagerDebut pq.NullTime `json:"manager_debut"`
+	CoachDebut   pq.NullTime `json:"coach_debut"`
+	UmpireDebut  pq.NullTime `json:"umpire_debut"`
+
+	// Attributes below come from the people register
+	GivenName  string `json:"given_name"`
+	Nickname   string `json:"nickname"`
+	NameSuffix string `json:"name_suffix"`
+
+	BirthDate    pq.NullTime `json:"birth_date"`
+	BirthCity    string      `json:"birth_city"`
+	BirthState   string      `json:"birth_state"`
+	BirthCountry string      `json:"birth_country"`
+
+	DeathDate    pq.NullTime `json:"death_date"`
+	DeathCity    string      `json:"death_city"`
+	DeathState   string      `json:"death_state"`
+	DeathCountry string      `json:"death_country"`
+
+	Bats   string        `json:"bats"`
+	Throws string        `json:"throws"`
+	Height sql.NullInt64 `json:"height"`
+	Weight sql.NullInt64 `json:"weight"`
+
+	// IDs of the person in other data sources, keyed by source name
+	CrossReferences map[string]string `json:"cross_references"`
 }
 
 func readRawPerson(line []string) *RawPerson {
@@ -26,10 +52,10 @@ func readRawPerson(line []string) *RawPerson {
 		PersonID:     line[0],
 		LastName:     line[1],
 		FirstName:    line[2],
-		PlayerDebut:  parseUSDate(line[3]),
-		ManagerDebut: parseUSDate(line[4]),
-		CoachDebut:   parseUSDate(line[5]),
-		UmpireDebut:  parseUSDate(line[6]),
+		PlayerDebut:  parseNullDate("01/02/2006", line[3]),
+		ManagerDebut: parseNullDate("01/02/2006", line[4]),
+		CoachDebut:   parseNullDate("01/02/2006", line[5]),
+		UmpireDebut:  parseNullDate("01/02/2006", line[6]),
 	}
 }
 
@@ -69,3 +95,179 @@ func loadPeople(path string) {
 
 	log.Printf("Inserted people")
 }
+
+// Register columns are matched by name, ignoring case and underscores,
+// so both the Chadwick register (key_retro, name_last) and Lahman (retroID, nameLast) headers work
+type RegisterColumns map[string]int
+
+func normalizeRegisterColumn(name string) string {
+	return strings.Replace(strings.ToLower(strings.TrimSpace(name)), "_", "", -1)
+}
+
+func readRegisterColumns(header []string) RegisterColumns {
+	columns := make(RegisterColumns)
+
+	for i, name := range header {
+		columns[normalizeRegisterColumn(name)] = i
+	}
+
+	return columns
+}
+
+// Returns the value of the first of the columns present in the register
+func (columns RegisterColumns) value(line []string, names ...string) string {
+	for _, name := range names {
+		i, ok := columns[name]
+
+		if ok && i < len(line) {
+			return strings.TrimSpace(line[i])
+		}
+	}
+
+	return ""
+}
+
+// Partial dates (e.g. only the birth year is known) are stored as null
+func parseDateParts(year string, month string, day string) pq.NullTime {
+	return parseNullDate("2006-1-2", fmt.Sprintf("%s-%s-%s", year, month, day))
+}
+
+func parseNullInt(value string) sql.NullInt64 {
+	i, err := strconv.ParseInt(value, 10, 64)
+
+	if err != nil {
+		return sql.NullInt64{}
+	}
+
+	return sql.NullInt64{Int64: i, Valid: true}
+}
+
+func readRegisterPerson(columns RegisterColumns, line []string) *RawPerson {
+	return &RawPerson{
+		PersonID:   columns.value(line, "keyretro", "retroid", "personid"),
+		LastName:   columns.value(line, "namelast", "lastname"),
+		FirstName:  columns.value(line, "namefirst", "firstname"),
+		GivenName:  columns.value(line, "namegiven", "givenname"),
+		Nickname:   columns.value(line, "namenick", "nickname"),
+		NameSuffix: columns.value(line, "namesuffix"),
+
+		BirthDate: parseDateParts(
+			columns.value(line, "birthyear"),
+			columns.value(line, "birthmonth"),
+			columns.value(line, "birthday"),
+		),
+		BirthCity:    columns.value(line, "birthcity"),
+		BirthState:   columns.value(line, "birthstate"),
+		BirthCountry: columns.value(line, "birthcountry"),
+
+		DeathDate: parseDateParts(
+			columns.value(line, "deathyear"),
+			columns.value(line, "deathmonth"),
+			columns.value(line, "deathday"),
+		),
+		DeathCity:    columns.value(line, "deathcity"),
+		DeathState:   columns.value(line, "deathstate"),
+		DeathCountry: columns.value(line, "deathcountry"),
+
+		Bats:   columns.value(line, "bats"),
+		Throws: columns.value(line, "throws"),
+		Height: parseNullInt(columns.value(line, "height")),
+		Weight: parseNullInt(columns.value(line, "weight")),
+
+		CrossReferences: readRegisterCrossReferences(columns, line),
+	}
+}
+
+func readRegisterCrossReferences(columns RegisterColumns, line []string) map[string]string {
+	crossReferences := make(map[string]string)
+
+	for source, names := range IDSourceColumns {
+		id := columns.value(line, names...)
+
+		if id != "" {
+			crossReferences[source] = id
+		}
+	}
+
+	return crossReferences
+}
+
+// Loads a people register CSV with a header row. People without a Retrosheet ID are skipped.
+// Debut dates loaded from the Retrosheet people file are kept.
+func loadPeopleRegister(path string) {
+	csvFile, err := os.Open(path)
+
+	if err != nil {
+		panic(err)
+	}
+
+	reader := csv.NewReader(bufio.NewReader(csvFile))
+
+	header, err := reader.Read()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	columns := readRegisterColumns(header)
+
+	var people []*RawPerson
+
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Fatal(err)
+		}
+
+		person := readRegisterPerson(columns, line)
+
+		if person.PersonID != "" {
+			people = append(people, person)
+		}
+	}
+
+	stmt := Statements["upsertRegisterPerson"]
+	idMappingStmt := Statements["upsertIDMapping"]
+
+	log.Println("Inserting people register")
+
+	for _, person := range people {
+		_, err := stmt.Exec(
+			person.PersonID,
+			person.LastName,
+			person.FirstName,
+			person.GivenName,
+			person.Nickname,
+			person.NameSuffix,
+			person.BirthDate,
+			person.BirthCity,
+			person.BirthState,
+			person.BirthCountry,
+			person.DeathDate,
+			person.DeathCity,
+			person.DeathState,
+			person.DeathCountry,
+			person.Bats,
+			person.Throws,
+			person.Height,
+			person.Weight,
+		)
+
+		if err != nil {
+			log.Printf("Error when inserting person %v %s", person, err)
+			continue
+		}
+
+		for source, id := range person.CrossReferences {
+			_, err := idMappingStmt.Exec(source, id, person.PersonID)
+
+			if err != nil {
+				log.Printf("Error when inserting %s id %s for person %s %s", source, id, person.PersonID, err)
+			}
+		}
+	}
+
+	log.Printf("Inserted %d people from the register", len(people))
+}
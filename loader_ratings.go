@@ -0,0 +1,44 @@
+package main
+
+import (
+	"log"
+)
+
+// Replays every loaded game and replaces the stored ratings
+func loadRatings(config EloConfig) {
+	games, err := loadAllGameScores()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Computing ratings from %d games", len(games))
+
+	ratings := computeEloRatings(games, config)
+
+	if _, err := Statements["deleteTeamRatings"].Exec(); err != nil {
+		log.Fatal(err)
+	}
+
+	stmt := Statements["insertTeamRating"]
+
+	log.Println("Inserting ratings")
+
+	for _, rating := range ratings {
+		_, err := stmt.Exec(
+			rating.Team,
+			rating.Date,
+			rating.NumberOfGame,
+			rating.Opponent,
+			rating.RatingBefore,
+			rating.RatingAfter,
+			rating.WinProbability,
+		)
+
+		if err != nil {
+			log.Printf("Error when inserting rating %v %s", rating, err)
+		}
+	}
+
+	log.Printf("Inserted %d ratings", len(ratings))
+}
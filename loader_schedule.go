@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type RawScheduledGame struct {
+	Date         time.Time
+	NumberOfGame string
+	DayOfWeek    string
+
+	VisitingTeam       string
+	VisitingTeamLeague string
+	VisitingGameNumber int
+
+	HomeTeam           string
+	HomeTeamLeague     string
+	HomeTeamGameNumber int
+
+	DayNightIndicator string
+
+	// Reason the game was not played as scheduled, empty if it was
+	PostponementInformation string
+	// Raw makeup field, can hold a date or a note like "No makeup played"
+	MakeupInformation string
+	MakeupDate        pq.NullTime
+}
+
+func (game *RawScheduledGame) Postponed() bool {
+	return game.PostponementInformation != ""
+}
+
+// Makeup field starts with a yyyymmdd date when the game was made up
+func parseMakeupDate(makeup string) pq.NullTime {
+	if len(makeup) < 8 {
+		return pq.NullTime{}
+	}
+
+	return parseNullDate("20060102", makeup[:8])
+}
+
+func readRawScheduledGame(line []string) *RawScheduledGame {
+	makeup := strings.TrimSpace(line[11])
+
+	return &RawScheduledGame{
+		Date:                    parseRetrosheetDate(line[0]),
+		NumberOfGame:            line[1],
+		DayOfWeek:               line[2],
+		VisitingTeam:            line[3],
+		VisitingTeamLeague:      line[4],
+		VisitingGameNumber:      parseInt(line[5]),
+		HomeTeam:                line[6],
+		HomeTeamLeague:          line[7],
+		HomeTeamGameNumber:      parseInt(line[8]),
+		DayNightIndicator:       line[9],
+		PostponementInformation: strings.TrimSpace(line[10]),
+		MakeupInformation:       makeup,
+		MakeupDate:              parseMakeupDate(makeup),
+	}
+}
+
+func loadSchedule(path string) {
+	csvFile, err := os.Open(path)
+
+	if err != nil {
+		panic(err)
+	}
+
+	reader := csv.NewReader(bufio.NewReader(csvFile))
+
+	var games []*RawScheduledGame
+
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Fatal(err)
+		}
+
+		games = append(games, readRawScheduledGame(line))
+	}
+
+	stmt := Statements["insertScheduledGame"]
+
+	log.Println("Inserting schedule")
+
+	for _, game := range games {
+		_, err := stmt.Exec(
+			game.Date,
+			game.NumberOfGame,
+			game.DayOfWeek,
+			game.VisitingTeam,
+			game.VisitingTeamLeague,
+			game.VisitingGameNumber,
+			game.HomeTeam,
+			game.HomeTeamLeague,
+			game.HomeTeamGameNumber,
+			game.DayNightIndicator,
+			game.PostponementInformation,
+			game.MakeupInformation,
+			game.MakeupDate,
+		)
+
+		if err != nil {
+			log.Printf("Error when inserting scheduled game %v %s", game, err)
+		}
+	}
+
+	log.Printf("Inserted %d scheduled games", len(games))
+}
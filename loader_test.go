@@ -1,11 +1,18 @@
 package main
 
 import (
+	"archive/zip"
+	"database/sql"
 	"encoding/csv"
+	"io/ioutil"
 	"log"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const data = `"20180329","0","Thu","BOS","AL",1,"TBA","AL",1,4,6,51,"D","","","","STP01",31042,180,"030000100","00000006x",33,8,4,0,1,4,0,0,0,2,0,6,0,0,0,0,4,4,6,6,0,0,24,6,0,1,0,0,28,4,1,1,0,6,0,0,0,7,0,11,0,0,0,0,5,3,4,4,0,0,27,12,0,0,1,0,"nelsj901","Jeff Nelson","diazl901","Laz Diaz","fleta901","Andy Fletcher","gonzm901","Manny Gonzalez","","(none)","","(none)","coraa001","Alex Cora","cashk001","Kevin Cash","pruia001","Austin Pruitt","smitc004","Carson Smith","coloa001","Alex Colome","spand001","Denard Span","salec001","Chris Sale","archc001","Chris Archer","bettm001","Mookie Betts",9,"benia002","Andrew Benintendi",7,"ramih003","Hanley Ramirez",3,"martj006","J.D. Martinez",10,"bogax001","Xander Bogaerts",6,"dever001","Rafael Devers",5,"nunee002","Eduardo Nunez",4,"bradj001","Jackie Bradley",8,"vazqc001","Christian Vazquez",2,"duffm002","Matt Duffy",5,"kierk001","Kevin Kiermaier",8,"gomec002","Carlos Gomez",9,"cronc002","C.J. Cron",3,"ramow001","Wilson Ramos",2,"spand001","Denard Span",7,"hecha001","Adeiny Hechavarria",6,"robed004","Daniel Robertson",4,"refsr001","Rob Refsnyder",10,"","Y"`
@@ -219,3 +226,248 @@ func TestReadLine(t *testing.T) {
 	assertEqual(t, game.AcquisitionInformation, "Y")
 
 }
+
+func TestReadRawScheduledGame(t *testing.T) {
+	r := csv.NewReader(strings.NewReader(`"20180415","0","Sun","KCA","AL",14,"LAA","AL",15,"d","Rain","20180416"`))
+
+	record, err := r.Read()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	game := readRawScheduledGame(record)
+
+	assertEqual(t, game.Date, time.Date(2018, time.April, 15, 0, 0, 0, 0, time.UTC))
+	assertEqual(t, game.VisitingTeam, "KCA")
+	assertEqual(t, game.VisitingGameNumber, 14)
+	assertEqual(t, game.HomeTeam, "LAA")
+	assertEqual(t, game.HomeTeamGameNumber, 15)
+	assertEqual(t, game.Postponed(), true)
+	assertEqual(t, game.PostponementInformation, "Rain")
+	assertEqual(t, game.MakeupDate.Valid, true)
+	assertEqual(t, game.MakeupDate.Time, time.Date(2018, time.April, 16, 0, 0, 0, 0, time.UTC))
+
+	game = readRawScheduledGame([]string{"20180415", "0", "Sun", "KCA", "AL", "14", "LAA", "AL", "15", "d", "Cold", "No makeup played"})
+
+	assertEqual(t, game.MakeupDate.Valid, false)
+	assertEqual(t, game.MakeupInformation, "No makeup played")
+}
+
+func TestMatchGameResults(t *testing.T) {
+	postponed := RawScheduledGame{
+		Date:                    time.Date(2018, time.April, 15, 0, 0, 0, 0, time.UTC),
+		NumberOfGame:            "0",
+		PostponementInformation: "Rain",
+	}
+	regular := RawScheduledGame{
+		Date:         time.Date(2018, time.April, 16, 0, 0, 0, 0, time.UTC),
+		NumberOfGame: "0",
+	}
+	results := []GameResult{
+		{Date: regular.Date, NumberOfGame: "1", VisitingTeamScore: 3, HomeTeamScore: 2},
+		{Date: regular.Date, NumberOfGame: "2", VisitingTeamScore: 5, HomeTeamScore: 4},
+	}
+
+	// The makeup was played as a doubleheader with the game scheduled for that date
+	entries := []RawScheduledGame{regular, postponed}
+
+	matched := matchGameResults(&postponed, entries, results)
+
+	assertEqual(t, len(matched), 1)
+	assertEqual(t, matched[0].NumberOfGame, "1")
+
+	matched = matchGameResults(&regular, entries, results)
+
+	assertEqual(t, len(matched), 1)
+	assertEqual(t, matched[0].NumberOfGame, "2")
+
+	// A doubleheader nobody scheduled stays with the only entry
+	matched = matchGameResults(&regular, []RawScheduledGame{regular}, results)
+
+	assertEqual(t, len(matched), 2)
+
+	matched = matchGameResults(&regular, []RawScheduledGame{regular}, []GameResult{})
+
+	assertEqual(t, len(matched), 0)
+}
+
+func TestParseGameID(t *testing.T) {
+	homeTeam, date, numberOfGame := parseGameID("BOS201804152")
+
+	assertEqual(t, homeTeam, "BOS")
+	assertEqual(t, date, time.Date(2018, time.April, 15, 0, 0, 0, 0, time.UTC))
+	assertEqual(t, numberOfGame, "2")
+
+	homeTeam, _, numberOfGame = parseGameID("BOS2018")
+
+	assertEqual(t, homeTeam, "")
+	assertEqual(t, numberOfGame, "")
+}
+
+func TestReadRawPark(t *testing.T) {
+	park := readRawPark([]string{"BOS07", "Fenway Park", "", "Boston", "MA", "04/20/1912", "", "AL"})
+
+	assertEqual(t, park.StartDate.Valid, true)
+	assertEqual(t, park.StartDate.Time, time.Date(1912, time.April, 20, 0, 0, 0, 0, time.UTC))
+	assertEqual(t, park.EndDate.Valid, false)
+
+	summary := getParkSummary(park)
+
+	assertEqual(t, summary.StartDate, "1912-04-20")
+	assertEqual(t, summary.EndDate, "")
+}
+
+func TestParkInUse(t *testing.T) {
+	date := func(value string) pq.NullTime {
+		return parseNullDate("2006-01-02", value)
+	}
+
+	tests := []struct {
+		name  string
+		park  RawPark
+		date  string
+		inUse bool
+	}{
+		{"open-ended", RawPark{StartDate: date("1912-04-20")}, "2018-04-03", true},
+		{"open-ended before opening", RawPark{StartDate: date("1912-04-20")}, "1912-04-19", false},
+		{"opening day", RawPark{StartDate: date("1912-04-20")}, "1912-04-20", true},
+		{"ended", RawPark{StartDate: date("1923-04-18"), EndDate: date("2008-09-21")}, "2009-04-13", false},
+		{"closing day", RawPark{StartDate: date("1923-04-18"), EndDate: date("2008-09-21")}, "2008-09-21", true},
+		{"not yet opened", RawPark{StartDate: date("2009-04-16")}, "2008-09-21", false},
+		{"no dates", RawPark{}, "2018-04-03", true},
+	}
+
+	for _, test := range tests {
+		day, _ := time.Parse("2006-01-02", test.date)
+
+		if test.park.InUse(day) != test.inUse {
+			t.Fatalf("%s: expected in use to be %t on %s", test.name, test.inUse, test.date)
+		}
+	}
+}
+
+func TestRoundedNullFloat(t *testing.T) {
+	assertEqual(t, roundedNullFloat(sql.NullFloat64{}) == nil, true)
+	assertEqual(t, roundedNullFloat(sql.NullFloat64{Float64: 12.5, Valid: false}) == nil, true)
+	assertEqual(t, *roundedNullFloat(sql.NullFloat64{Float64: 0, Valid: true}), 0.0)
+	assertEqual(t, *roundedNullFloat(sql.NullFloat64{Float64: 181.46, Valid: true}), 181.5)
+}
+
+func TestParseSeasonRange(t *testing.T) {
+	seasons, err := parseSeasonRange("1990-2018")
+
+	assertEqual(t, err, nil)
+	assertEqual(t, seasons.Contains(1989), false)
+	assertEqual(t, seasons.Contains(1990), true)
+	assertEqual(t, seasons.Contains(2018), true)
+
+	seasons, err = parseSeasonRange("2018")
+
+	assertEqual(t, err, nil)
+	assertEqual(t, seasons.Contains(2017), false)
+	assertEqual(t, seasons.Contains(2018), true)
+
+	seasons, _ = parseSeasonRange("")
+
+	assertEqual(t, seasons.Contains(1871), true)
+
+	_, err = parseSeasonRange("2018-1990")
+
+	if err == nil {
+		t.Fatal("Expected an error for an inverted range")
+	}
+}
+
+func TestParseGamesFromZip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gamelogs")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "gl2017_2018.zip")
+
+	zipFile, err := os.Create(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	archive := zip.NewWriter(zipFile)
+
+	for _, name := range []string{"GL2017.TXT", "GL2018.TXT", "README.md"} {
+		entry, _ := archive.Create(name)
+		entry.Write([]byte(data))
+	}
+
+	archive.Close()
+	zipFile.Close()
+
+	seasons, _ := parseSeasonRange("2018")
+
+	var sources []string
+	gamesCount := 0
+
+	err = parseGamesFromFile(path, seasons, func(source string, games []*Game) {
+		sources = append(sources, source)
+		gamesCount += len(games)
+	})
+
+	assertEqual(t, err, nil)
+	assertEqual(t, len(sources), 1)
+	assertEqual(t, sources[0], path+":GL2018.TXT")
+	assertEqual(t, gamesCount, 1)
+}
+
+func TestReadRegisterPerson(t *testing.T) {
+	r := csv.NewReader(strings.NewReader(`key_person,key_retro,key_mlbam,key_bbref,name_last,name_first,name_given,birth_year,birth_month,birth_day,death_year,death_month,death_day,height
+2b3f4c6e,bettm001,605141,bettsmo01,Betts,Mookie,Markus Lynn,1992,10,7,,,,69`))
+
+	records, err := r.ReadAll()
+
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	initPeopleConstants()
+
+	person := readRegisterPerson(readRegisterColumns(records[0]), records[1])
+
+	assertEqual(t, person.PersonID, "bettm001")
+	assertEqual(t, person.LastName, "Betts")
+	assertEqual(t, person.FirstName, "Mookie")
+	assertEqual(t, person.GivenName, "Markus Lynn")
+	assertEqual(t, person.BirthDate.Valid, true)
+	assertEqual(t, person.BirthDate.Time, time.Date(1992, time.October, 7, 0, 0, 0, 0, time.UTC))
+	assertEqual(t, person.DeathDate.Valid, false)
+	assertEqual(t, person.Height.Int64, int64(69))
+	assertEqual(t, person.Weight.Valid, false)
+	assertEqual(t, person.CrossReferences["mlbam"], "605141")
+	assertEqual(t, person.CrossReferences["bbref"], "bettsmo01")
+	assertEqual(t, len(person.CrossReferences), 2)
+	assertEqual(t, person.Bats, "")
+}
+
+func TestGetTeamNameData(t *testing.T) {
+	FRANCHISE_ERAS = make(map[string][]*RawFranchiseEra)
+	TEAMS = make(map[string]*RawTeam)
+
+	for _, line := range [][]string{
+		{"LAN", "BRO", "NL", "", "Brooklyn", "Dodgers", "", "4/14/1932", "9/24/1957", "Brooklyn", "NY"},
+		{"LAN", "LAN", "NL", "W", "Los Angeles", "Dodgers", "", "4/15/1958", "", "Los Angeles", "CA"},
+	} {
+		era := readRawFranchiseEra(line)
+		FRANCHISE_ERAS[era.TeamSymbol] = append(FRANCHISE_ERAS[era.TeamSymbol], era)
+	}
+
+	TEAMS["LAN"] = &RawTeam{TeamSymbol: "LAN", Location: "Los Angeles", Name: "Dodgers"}
+
+	assertEqual(t, getTeamNameData("BRO", time.Date(1955, time.October, 4, 0, 0, 0, 0, time.UTC)).FullName, "Brooklyn Dodgers")
+	assertEqual(t, getTeamNameData("BRO", time.Date(1960, time.May, 1, 0, 0, 0, 0, time.UTC)).FullName, "")
+	assertEqual(t, getTeamNameData("LAN", time.Date(2018, time.March, 29, 0, 0, 0, 0, time.UTC)).FullName, "Los Angeles Dodgers")
+	assertEqual(t, len(getFranchiseHistory("LAN")), 2)
+	assertEqual(t, getFranchiseHistory("LAN")[0].TeamSymbol, "BRO")
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+	"log"
+	"os"
+
+	"github.com/lib/pq"
+)
+
+type RawTransaction struct {
+	TransactionID            string
+	PersonID                 string
+	PrimaryDate              pq.NullTime
+	PrimaryDateApproximate   string
+	TimeOfDay                string
+	SecondaryDate            pq.NullTime
+	SecondaryDateApproximate string
+	Type                     string
+	FromTeam                 string
+	FromLeague               string
+	ToTeam                   string
+	ToLeague                 string
+	DraftType                string
+	DraftRound               int
+	PickNumber               int
+	Information              string
+}
+
+// Dates with an unknown month or day (e.g. 19050000) are stored as null
+func readRawTransaction(line []string) *RawTransaction {
+	return &RawTransaction{
+		PrimaryDate:              parseNullDate("20060102", line[0]),
+		TimeOfDay:                line[1],
+		PrimaryDateApproximate:   line[2],
+		SecondaryDate:            parseNullDate("20060102", line[3]),
+		SecondaryDateApproximate: line[4],
+		TransactionID:            line[5],
+		PersonID:                 line[6],
+		Type:                     line[7],
+		FromTeam:                 line[8],
+		FromLeague:               line[9],
+		ToTeam:                   line[10],
+		ToLeague:                 line[11],
+		DraftType:                line[12],
+		DraftRound:               parseInt(line[13]),
+		PickNumber:               parseInt(line[14]),
+		Information:              line[15],
+	}
+}
+
+func loadTransactions(path string) {
+	csvFile, err := os.Open(path)
+
+	if err != nil {
+		panic(err)
+	}
+
+	reader := csv.NewReader(bufio.NewReader(csvFile))
+
+	var transactions []*RawTransaction
+
+	for {
+		line, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			log.Fatal(err)
+		}
+
+		transactions = append(transactions, readRawTransaction(line))
+	}
+
+	stmt := Statements["insertTransaction"]
+
+	log.Println("Inserting transactions")
+
+	for _, transaction := range transactions {
+		_, err := stmt.Exec(
+			transaction.TransactionID,
+			transaction.PersonID,
+			transaction.PrimaryDate,
+			transaction.PrimaryDateApproximate,
+			transaction.TimeOfDay,
+			transaction.SecondaryDate,
+			transaction.SecondaryDateApproximate,
+			transaction.Type,
+			transaction.FromTeam,
+			transaction.FromLeague,
+			transaction.ToTeam,
+			transaction.ToLeague,
+			transaction.DraftType,
+			transaction.DraftRound,
+			transaction.PickNumber,
+			transaction.Information,
+		)
+
+		if err != nil {
+			log.Printf("Error when inserting transaction %v %s", transaction, err)
+		}
+	}
+
+	log.Printf("Inserted transactions")
+}
@@ -194,6 +194,10 @@ primary key(visiting_team, home_team, game_date, number_of_game)
 );
 
 create index i_game_date_teams on game(visiting_team, home_team, game_date);
+create index i_game_date on game(game_date);
+create index i_game_home_team_date on game(home_team, game_date);
+create index i_game_visiting_starter on game(visiting_starting_pitcher_id, game_date);
+create index i_game_home_starter on game(home_starting_pitcher_id, game_date);
 
 -- Teams
 
@@ -233,5 +237,141 @@ create table person (
     coach_debut date,
     umpire_debut date,
 
+    given_name varchar,
+    nickname varchar,
+    name_suffix varchar,
+
+    birth_date date,
+    birth_city varchar,
+    birth_state varchar,
+    birth_country varchar,
+
+    death_date date,
+    death_city varchar,
+    death_state varchar,
+    death_country varchar,
+
+    bats varchar,
+    throws varchar,
+    height int,
+    weight int,
+
     primary key(person_id)
-);
\ No newline at end of file
+);
+
+-- Person IDs used by other data sources
+
+create table id_map (
+    source varchar,
+    source_id varchar,
+    person_id varchar,
+
+    primary key(source, source_id)
+);
+
+create index i_id_map_person on id_map(person_id, source);
+-- Schedule
+
+create table schedule (
+    game_date date,
+    number_of_game varchar,
+    day_of_week varchar,
+
+    visiting_team varchar,
+    visiting_team_league varchar,
+    visiting_game_number int,
+
+    home_team varchar,
+    home_team_league varchar,
+    home_team_game_number int,
+
+    day_night_indicator varchar,
+    postponement_information varchar,
+    makeup_information varchar,
+    makeup_date date,
+
+    primary key(visiting_team, home_team, game_date, number_of_game)
+);
+
+create index i_schedule_game_date on schedule(game_date);
+create index i_schedule_makeup_date on schedule(makeup_date);
+
+-- Ejections
+
+create table ejection (
+    game_id varchar,
+    game_date date,
+    home_team varchar,
+    number_of_game varchar,
+    person_id varchar,
+    person_name varchar,
+    team varchar,
+    job varchar,
+    umpire_id varchar,
+    umpire_name varchar,
+    inning int,
+    reason varchar,
+
+    primary key(game_id, person_id)
+);
+
+create index i_ejection_game on ejection(home_team, game_date);
+
+-- Transactions
+
+create table player_transaction (
+    transaction_id varchar,
+    person_id varchar,
+    primary_date date,
+    primary_date_approximate varchar,
+    time_of_day varchar,
+    secondary_date date,
+    secondary_date_approximate varchar,
+    transaction_type varchar,
+    from_team varchar,
+    from_league varchar,
+    to_team varchar,
+    to_league varchar,
+    draft_type varchar,
+    draft_round int,
+    pick_number int,
+    information varchar,
+
+    primary key(transaction_id, person_id)
+);
+
+create index i_player_transaction_person on player_transaction(person_id);
+
+-- Franchises
+
+create table franchise_era (
+    franchise_id varchar,
+    team_symbol varchar,
+    league varchar,
+    division varchar,
+    location varchar,
+    name varchar,
+    alternate_name varchar,
+    start_date date,
+    end_date date,
+    city varchar,
+    state varchar,
+
+    primary key(team_symbol, start_date)
+);
+
+-- Elo ratings, one row per team per game
+
+create table team_rating (
+    team varchar,
+    game_date date,
+    number_of_game varchar,
+    opponent varchar,
+    rating_before double precision,
+    rating_after double precision,
+    win_probability double precision,
+
+    primary key(team, game_date, number_of_game)
+);
+
+create index i_team_rating_game_date on team_rating(game_date);